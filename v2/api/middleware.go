@@ -1,12 +1,15 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
-	"net/http/httputil"
 	"os"
+	"strings"
+	"time"
 )
 
 type Middleware interface {
@@ -68,33 +71,149 @@ func (m *ErrorHandlerMiddleware) RoundTrip(req *http.Request) (*http.Response, e
 	return resp, err
 }
 
-// TraceMiddleware is a client HTTP middleware that dumps HTTP requests and responses content.
+// TraceMiddleware is a client HTTP middleware that logs HTTP requests and
+// responses content, with the sensitive headers (e.g. Authorization) and body
+// fields (e.g. passwords, kubeconfigs, user-data) redacted.
 type TraceMiddleware struct {
-	next http.RoundTripper
+	next   http.RoundTripper
+	logger *slog.Logger
 }
 
+// NewTraceMiddleware returns a TraceMiddleware logging to stderr.
 func NewTraceMiddleware(next http.RoundTripper) Middleware {
+	return NewTraceMiddlewareWithLogger(next, nil)
+}
+
+// NewTraceMiddlewareWithLogger returns a TraceMiddleware logging to the given
+// logger at debug level, or to stderr if logger is nil.
+func NewTraceMiddlewareWithLogger(next http.RoundTripper, logger *slog.Logger) Middleware {
 	if next == nil {
 		next = http.DefaultTransport
 	}
 
-	return &TraceMiddleware{next: next}
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+
+	return &TraceMiddleware{next: next, logger: logger}
 }
 
 func (t *TraceMiddleware) RoundTrip(req *http.Request) (*http.Response, error) {
-	if dump, err := httputil.DumpRequest(req, true); err == nil {
-		fmt.Fprintf(os.Stderr, ">>> %s\n", dump)
+	ctx := req.Context()
+	if !t.logger.Enabled(ctx, slog.LevelDebug) {
+		return t.next.RoundTrip(req)
 	}
 
-	fmt.Fprintln(os.Stderr, "----------------------------------------------------------------------")
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Any("request_headers", redactHeader(req.Header)),
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			attrs = append(attrs, slog.String("request_body", redactBody(data)))
+		}
+	}
 
+	start := time.Now()
 	resp, err := t.next.RoundTrip(req)
+	attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
 
 	if resp != nil {
-		if dump, err := httputil.DumpResponse(resp, true); err == nil {
-			fmt.Fprintf(os.Stderr, "<<< %s\n", dump)
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode),
+			slog.Any("response_headers", redactHeader(resp.Header)),
+		)
+
+		data, rerr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if rerr == nil {
+			attrs = append(attrs, slog.String("response_body", redactBody(data)))
 		}
 	}
 
+	t.logger.LogAttrs(ctx, slog.LevelDebug, "API request", attrs...)
+
 	return resp, err
 }
+
+const redacted = "REDACTED"
+
+// sensitiveHeaders are the HTTP headers redacted from traces.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are the JSON fields redacted from traced bodies, in addition to
+// the fields holding URIs which can embed credentials. They must match those of
+// the v3 client (v3/logging.go), which TestSensitiveFieldsInSync checks.
+var sensitiveFields = map[string]bool{
+	"password":       true,
+	"admin-password": true,
+	"secret":         true,
+	// Private key of the TLS client certificate of DBaaS Kafka users.
+	"access-key": true,
+	"kubeconfig": true,
+	"user-data":  true,
+	"uri-params": true,
+}
+
+// redactHeader returns a copy of an HTTP header with the sensitive values redacted.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+
+	return h
+}
+
+// redactBody returns a JSON body with the sensitive fields redacted.
+// Non-JSON bodies are omitted, since they cannot be redacted.
+func redactBody(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "<body omitted>"
+	}
+
+	return string(out)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if isSensitiveField(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(val)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+
+	return v
+}
+
+func isSensitiveField(name string) bool {
+	return sensitiveFields[name] || name == "uri" || strings.HasSuffix(name, "-uri")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"net/url"
@@ -94,6 +95,20 @@ func ClientOptWithTrace() ClientOpt {
 	}
 }
 
+// ClientOptWithLogger returns a ClientOpt enabling HTTP request/response tracing
+// to the given logger, at debug level. Sensitive headers and body fields are redacted.
+func ClientOptWithLogger(logger *slog.Logger) ClientOpt {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+
+		c.logger = logger
+
+		return nil
+	}
+}
+
 // ClientOptCond returns the specified ClientOpt if the fc function bool result
 // evaluates to true, otherwise returns a no-op ClientOpt.
 func ClientOptCond(fc func() bool, opt ClientOpt) ClientOpt {
@@ -132,6 +147,7 @@ type Client struct {
 	timeout      time.Duration
 	pollInterval time.Duration
 	trace        bool
+	logger       *slog.Logger
 	httpClient   *http.Client
 }
 
@@ -173,8 +189,8 @@ func NewClient(apiKey, apiSecret string, opts ...ClientOpt) (*Client, error) {
 
 	// Tracing must be performed before API error handling in the middleware chain,
 	// otherwise the response won't be dumped in case of an API error.
-	if client.trace {
-		client.httpClient.Transport = api.NewTraceMiddleware(client.httpClient.Transport)
+	if client.trace || client.logger != nil {
+		client.httpClient.Transport = api.NewTraceMiddlewareWithLogger(client.httpClient.Transport, client.logger)
 	}
 
 	client.httpClient.Transport = api.NewAPIErrorHandlerMiddleware(client.httpClient.Transport)
@@ -215,8 +231,8 @@ func (c *Client) SetHTTPClient(client *http.Client) {
 
 	// Tracing must be performed before API error handling in the middleware chain,
 	// otherwise the response won't be dumped in case of an API error.
-	if c.trace {
-		c.httpClient.Transport = api.NewTraceMiddleware(c.httpClient.Transport)
+	if c.trace || c.logger != nil {
+		c.httpClient.Transport = api.NewTraceMiddlewareWithLogger(c.httpClient.Transport, c.logger)
 	}
 
 	c.httpClient.Transport = api.NewAPIErrorHandlerMiddleware(c.httpClient.Transport)
@@ -19,6 +19,8 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+
+	"github.com/sauterp/egoscale/v3/credentials"
 )
 
 var (
@@ -33,6 +35,13 @@ var (
 
 	// ErrAPIError represents an error indicating an API-side issue.
 	ErrAPIError = errors.New("API error")
+
+	// ErrImmutable represents an error indicating that a resource field cannot be updated.
+	ErrImmutable = errors.New("immutable field")
+
+	// ErrDeprecatedResources represents an error indicating that an SKS cluster
+	// still uses Kubernetes resources deprecated by the requested version.
+	ErrDeprecatedResources = errors.New("deprecated resources remain")
 )
 
 type UUID string
@@ -50,66 +59,6 @@ func ParseUUID(s string) (UUID, error) {
 	return UUID(id.String()), nil
 }
 
-// Wait is a helper that waits for async operation to reach the final state.
-// Final states are one of: failure, success, timeout.
-// If states argument are given, returns an error if the final state not match on of those.
-func (c Client) Wait(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error) {
-	if op == nil {
-		return nil, fmt.Errorf("operation is nil")
-	}
-
-	ticker := time.NewTicker(c.pollingInterval)
-	defer ticker.Stop()
-
-	if op.State != OperationStatePending {
-		return op, nil
-	}
-
-	var operation *Operation
-polling:
-	for {
-		select {
-		case <-ticker.C:
-			o, err := c.GetOperation(ctx, op.ID)
-			if err != nil {
-				return nil, err
-			}
-			if o.State == OperationStatePending {
-				continue
-			}
-
-			operation = o
-			break polling
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		}
-	}
-
-	if len(states) == 0 {
-		return operation, nil
-	}
-
-	for _, st := range states {
-		if operation.State == st {
-			return operation, nil
-		}
-	}
-
-	var ref OperationReference
-	if operation.Reference != nil {
-		ref = *operation.Reference
-	}
-
-	return nil,
-		fmt.Errorf("operation: %q %v, state: %s, reason: %q, message: %q",
-			operation.ID,
-			ref,
-			operation.State,
-			operation.Reason,
-			operation.Message,
-		)
-}
-
 func String(s string) *string {
 	return &s
 }
@@ -177,7 +126,7 @@ func prepareJSONResponse(resp *http.Response, v any) error {
 	return nil
 }
 
-func (c Client) signRequest(req *http.Request) error {
+func (c Client) signRequest(req *http.Request, creds credentials.Value) error {
 	var (
 		sigParts    []string
 		headerParts []string
@@ -187,7 +136,7 @@ func (c Client) signRequest(req *http.Request) error {
 
 	// Request method/URL path
 	sigParts = append(sigParts, fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath()))
-	headerParts = append(headerParts, "EXO2-HMAC-SHA256 credential="+c.apiKey)
+	headerParts = append(headerParts, "EXO2-HMAC-SHA256 credential="+creds.APIKey)
 
 	// Request body if present
 	body := ""
@@ -222,7 +171,7 @@ func (c Client) signRequest(req *http.Request) error {
 	sigParts = append(sigParts, fmt.Sprint(expiration.Unix()))
 	headerParts = append(headerParts, "expires="+fmt.Sprint(expiration.Unix()))
 
-	h := hmac.New(sha256.New, []byte(c.apiSecret))
+	h := hmac.New(sha256.New, []byte(creds.APISecret))
 	if _, err := h.Write([]byte(strings.Join(sigParts, "\n"))); err != nil {
 		return err
 	}
@@ -256,52 +205,212 @@ func extractRequestParameters(req *http.Request) ([]string, string) {
 	return names, values
 }
 
-func handleHTTPErrorResp(resp *http.Response) error {
-	if resp.StatusCode >= 400 && resp.StatusCode <= 599 {
-		var res struct {
-			Message string `json:"message"`
+// do signs and sends an HTTP request, retrying it according to the Client retry policy.
+// The request is signed again before every attempt since the signature embeds an
+// expiration timestamp, and with the current credentials since they may have been renewed.
+// If the API rejects the credentials, they are retrieved again and the request is
+// sent once more if they changed.
+func (c Client) do(ctx context.Context, req *http.Request, operationID string) (*http.Response, error) {
+	if c.instrumentation == nil && c.logger == nil {
+		resp, _, err := c.send(ctx, req, operationID)
+		return resp, err
+	}
+
+	start := time.Now()
+
+	var done func(RequestResult)
+	if c.instrumentation != nil {
+		ctx, done = c.instrumentation.StartRequest(ctx, RequestInfo{
+			OperationID: operationID,
+			Zone:        c.zone(),
+			Request:     req,
+		})
+		req = req.WithContext(ctx)
+	}
+
+	resp, retries, err := c.send(ctx, req, operationID)
+
+	result := RequestResult{Retries: retries, Duration: time.Since(start), Err: err}
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+	}
+	if done != nil {
+		done(result)
+	}
+	if c.logger != nil {
+		c.logger.log(ctx, req, resp, operationID, result)
+	}
+
+	return resp, err
+}
+
+// send implements do, also returning the number of retries.
+func (c Client) send(ctx context.Context, req *http.Request, operationID string) (*http.Response, int, error) {
+	var (
+		attempt   int
+		refreshed bool
+	)
+	for sent := false; ; sent = true {
+		if sent && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, attempt, fmt.Errorf("rewind request body: %w", err)
+			}
+			req.Body = body
 		}
 
+		release, err := c.rateLimiter.acquire(ctx, c.serverEndpoint, req.Method)
+		if err != nil {
+			return nil, attempt, err
+		}
+
+		creds, err := c.credentials.Get()
+		if err != nil {
+			release()
+			return nil, attempt, fmt.Errorf("get credentials: %w", err)
+		}
+
+		if err := c.signRequest(req, creds); err != nil {
+			release()
+			return nil, attempt, fmt.Errorf("sign request: %w", err)
+		}
+
+		if c.trace {
+			dumpRequest(req, operationID)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		release()
+		if err == nil && c.trace {
+			dumpResponse(resp)
+		}
+
+		if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+			c.rateLimiter.throttled(c.serverEndpoint, req.Method, resp)
+		}
+
+		if err == nil && !refreshed && isAuthError(resp) {
+			refreshed = true
+			if c.refreshCredentials(creds) {
+				_, _ = io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+				continue
+			}
+		}
+
+		wait, retry := c.retryPolicy.shouldRetry(req, resp, err, attempt)
+		if !retry {
+			return resp, attempt, err
+		}
+		attempt++
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, ctx.Err()
+		}
+	}
+}
+
+// isAuthError returns whether the API rejected the request credentials.
+// Since the API also answers 403 to valid credentials lacking permissions,
+// the request is only retried if refreshing the credentials changes them.
+func isAuthError(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
+}
+
+// refreshCredentials retrieves the Client credentials again, unless a
+// concurrent request already did, returning whether they differ from the
+// stale ones used.
+func (c Client) refreshCredentials(stale credentials.Value) bool {
+	creds, err := c.credentials.Refresh(stale)
+	if err != nil {
+		return false
+	}
+
+	return creds != stale
+}
+
+func handleHTTPErrorResp(resp *http.Response, operationID string) error {
+	if resp.StatusCode >= 400 && resp.StatusCode <= 599 {
+		defer resp.Body.Close()
+
 		data, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return fmt.Errorf("error reading response body: %s", err)
 		}
 
+		apiErr := &APIError{
+			StatusCode:  resp.StatusCode,
+			OperationID: operationID,
+			Header:      resp.Header,
+			Body:        data,
+		}
+		if resp.Request != nil {
+			apiErr.Method = resp.Request.Method
+		}
+
 		if json.Valid(data) {
-			if err = json.Unmarshal(data, &res); err != nil {
-				return fmt.Errorf("error unmarshaling response: %s", err)
+			var details map[string]any
+			if err = json.Unmarshal(data, &details); err == nil {
+				if msg, ok := details["message"].(string); ok {
+					apiErr.Message = msg
+					delete(details, "message")
+				}
+				if len(details) > 0 {
+					apiErr.Details = details
+				}
 			}
 		} else {
-			res.Message = string(data)
+			apiErr.Message = string(data)
 		}
 
-		switch {
-		case resp.StatusCode == http.StatusNotFound:
-			return ErrNotFound
-
-		case resp.StatusCode >= 400 && resp.StatusCode < 500:
-			return fmt.Errorf("%w: %s", ErrInvalidRequest, res.Message)
-
-		case resp.StatusCode >= 500:
-			return fmt.Errorf("%w: %s", ErrAPIError, res.Message)
-		}
+		return apiErr
 	}
 
 	return nil
 }
 
+// dumpRequest writes a request to stderr, with its sensitive headers and body
+// fields redacted.
 func dumpRequest(req *http.Request, operationID string) {
 	if req != nil {
-		if dump, err := httputil.DumpRequest(req, true); err == nil {
-			fmt.Fprintf(os.Stderr, ">>> Operation: %s\n%s\n", operationID, dump)
+		r := req.Clone(req.Context())
+		r.Header = redactHeader(req.Header)
+		if dump, err := httputil.DumpRequest(r, false); err == nil {
+			fmt.Fprintf(os.Stderr, ">>> Operation: %s\n%s", operationID, dump)
+			if req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					data, _ := io.ReadAll(body)
+					body.Close()
+					fmt.Fprintln(os.Stderr, redactBody(data))
+				}
+			}
+			fmt.Fprintln(os.Stderr)
 		}
 	}
 }
 
+// dumpResponse writes a response to stderr, with its sensitive headers and body
+// fields redacted.
 func dumpResponse(resp *http.Response) {
 	if resp != nil {
-		if dump, err := httputil.DumpResponse(resp, true); err == nil {
-			fmt.Fprintf(os.Stderr, "<<< %s\n", dump)
+		r := *resp
+		r.Header = redactHeader(resp.Header)
+		if dump, err := httputil.DumpResponse(&r, false); err == nil {
+			fmt.Fprintf(os.Stderr, "<<< %s", dump)
+			if data, err := io.ReadAll(resp.Body); err == nil {
+				resp.Body.Close()
+				resp.Body = io.NopCloser(bytes.NewReader(data))
+				fmt.Fprintln(os.Stderr, redactBody(data))
+			}
 			fmt.Fprintln(os.Stderr, "----------------------------------------------------------------------")
 		}
 	}
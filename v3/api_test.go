@@ -0,0 +1,118 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+// rotatingProvider returns its current key, and never expires by itself.
+type rotatingProvider struct {
+	key       string
+	retrieved atomic.Int32
+}
+
+func (p *rotatingProvider) Retrieve() (credentials.Value, error) {
+	p.retrieved.Add(1)
+	return credentials.Value{APIKey: p.key, APISecret: "secret"}, nil
+}
+
+func (p *rotatingProvider) IsExpired() bool {
+	return false
+}
+
+func TestClientCredentialsRefresh(t *testing.T) {
+	var keys []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(strings.Split(r.Header.Get("Authorization"), ",")[0], "EXO2-HMAC-SHA256 credential=")
+		keys = append(keys, key)
+
+		if key != "EXOnew" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"invalid API key"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	}))
+	defer ts.Close()
+
+	provider := &rotatingProvider{key: "EXOold"}
+	client, err := NewClient(credentials.NewCredentials(provider), ClientOptWithEndpoint(Endpoint(ts.URL)))
+	require.NoError(t, err)
+
+	_, err = client.ListZones(context.Background())
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	require.Equal(t, []string{"EXOold"}, keys)
+
+	provider.key = "EXOnew"
+	keys = nil
+	_, err = client.ListZones(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"EXOold", "EXOnew"}, keys)
+
+	keys = nil
+	_, err = client.WithEndpoint(Endpoint(ts.URL)).ListZones(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"EXOnew"}, keys)
+}
+
+func TestClientCredentialsRefreshForbidden(t *testing.T) {
+	var requests int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"missing permission"}`))
+	}))
+	defer ts.Close()
+
+	provider := &rotatingProvider{key: "EXOkey"}
+	client, err := NewClient(credentials.NewCredentials(provider), ClientOptWithEndpoint(Endpoint(ts.URL)))
+	require.NoError(t, err)
+
+	// Valid credentials lacking permissions are retrieved again, but the
+	// request isn't retried with the same ones.
+	_, err = client.ListZones(context.Background())
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	require.EqualValues(t, 2, provider.retrieved.Load())
+	require.Equal(t, 1, requests)
+}
+
+func TestClientCredentialsRefreshConcurrent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "EXO2-HMAC-SHA256 credential=EXOnew,") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	}))
+	defer ts.Close()
+
+	provider := &rotatingProvider{key: "EXOold"}
+	client, err := NewClient(credentials.NewCredentials(provider), ClientOptWithEndpoint(Endpoint(ts.URL)))
+	require.NoError(t, err)
+	provider.key = "EXOnew"
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.ListZones(context.Background())
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// The requests rejected with the same stale credentials share a single refresh.
+	require.EqualValues(t, 2, provider.retrieved.Load())
+}
@@ -0,0 +1,277 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+// APIKeyTimeFormat is the layout of the creation time suffixed by RotateAPIKey
+// to the names of the API keys it creates, e.g. "ci-20241018T093000Z".
+const APIKeyTimeFormat = "20060102T150405Z"
+
+var apiKeyTimeSuffix = regexp.MustCompile(`-(\d{8}T\d{6}Z)$`)
+
+// APIKeyCreatedAt returns the creation time recorded in the name of an API key
+// created by RotateAPIKey, or false if it doesn't have one.
+func APIKeyCreatedAt(key IAMAPIKey) (time.Time, bool) {
+	m := apiKeyTimeSuffix.FindStringSubmatch(key.Name)
+	if m == nil {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(APIKeyTimeFormat, m[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// APIKeySink receives the credentials of the API keys created by RotateAPIKey,
+// to hand them to the workloads using them.
+type APIKeySink interface {
+	Store(ctx context.Context, key *IAMAPIKeyCreated) error
+}
+
+// APIKeySinkFunc is an adapter to use a function as an APIKeySink.
+type APIKeySinkFunc func(ctx context.Context, key *IAMAPIKeyCreated) error
+
+// Store calls f(ctx, key).
+func (f APIKeySinkFunc) Store(ctx context.Context, key *IAMAPIKeyCreated) error {
+	return f(ctx, key)
+}
+
+// APIKeyFileSink is an APIKeySink writing the API key and secret to a single
+// file, on its first and second lines, such as read by
+// credentials.NewAPIKeyFileCredentials.
+type APIKeyFileSink struct {
+	File string
+}
+
+// Store replaces the content of the file atomically, so that readers never see
+// the key of an API key along with the secret of another one. The file is
+// created with the 0600 mode.
+func (s APIKeyFileSink) Store(_ context.Context, key *IAMAPIKeyCreated) error {
+	return writeFileAtomic(s.File, []byte(key.Key+"\n"+key.Secret+"\n"))
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// RotateAPIKeyOpt represents a function setting a RotateAPIKey option.
+type RotateAPIKeyOpt func(*rotateAPIKeyOptions)
+
+type rotateAPIKeyOptions struct {
+	name          string
+	gracePeriod   time.Duration
+	verify        func(ctx context.Context, client *Client) error
+	verifyTimeout time.Duration
+}
+
+// RotateAPIKeyOptWithName returns a RotateAPIKeyOpt overriding the name of
+// the new API key (default: the name of the old API key, with the creation time
+// suffix replaced).
+func RotateAPIKeyOptWithName(name string) RotateAPIKeyOpt {
+	return func(o *rotateAPIKeyOptions) {
+		o.name = name
+	}
+}
+
+// RotateAPIKeyOptWithGracePeriod returns a RotateAPIKeyOpt setting the duration
+// to keep the old API key once the new one is stored, for the workloads to pick
+// the new one up (default: 0).
+func RotateAPIKeyOptWithGracePeriod(d time.Duration) RotateAPIKeyOpt {
+	return func(o *rotateAPIKeyOptions) {
+		o.gracePeriod = d
+	}
+}
+
+// RotateAPIKeyOptWithVerification returns a RotateAPIKeyOpt overriding the call
+// verifying that the new API key can authenticate, made with a Client using it
+// (default: ListZones), e.g. with a call the IAM role of the API key allows.
+func RotateAPIKeyOptWithVerification(fn func(ctx context.Context, client *Client) error) RotateAPIKeyOpt {
+	return func(o *rotateAPIKeyOptions) {
+		o.verify = fn
+	}
+}
+
+// RotateAPIKeyOptWithVerificationTimeout returns a RotateAPIKeyOpt overriding
+// the maximum duration to retry the verification of the new API key while it
+// propagates (default: 1m).
+func RotateAPIKeyOptWithVerificationTimeout(d time.Duration) RotateAPIKeyOpt {
+	return func(o *rotateAPIKeyOptions) {
+		o.verifyTimeout = d
+	}
+}
+
+// RotateAPIKey replaces an API key by a new one bound to the same IAM role:
+// the new API key is created, verified to authenticate, handed to the sink,
+// then the old API key is deleted once the grace period elapsed.
+//
+// The new API key is deleted if it can't be verified or stored, leaving the
+// old one in place. If the old API key can't be deleted, the new one is
+// returned along with the error.
+func (c Client) RotateAPIKey(ctx context.Context, key string, sink APIKeySink, opts ...RotateAPIKeyOpt) (*IAMAPIKeyCreated, error) {
+	o := &rotateAPIKeyOptions{
+		verify: func(ctx context.Context, client *Client) error {
+			_, err := client.ListZones(ctx)
+			return err
+		},
+		verifyTimeout: time.Minute,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	old, err := c.GetAPIKey(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("RotateAPIKey: %w", err)
+	}
+
+	if o.name == "" {
+		o.name = apiKeyTimeSuffix.ReplaceAllString(old.Name, "") + "-" + time.Now().UTC().Format(APIKeyTimeFormat)
+	}
+
+	created, err := c.CreateAPIKey(ctx, CreateAPIKeyRequest{Name: o.name, RoleID: old.RoleID})
+	if err != nil {
+		return nil, fmt.Errorf("RotateAPIKey: %w", err)
+	}
+
+	if err := c.verifyAPIKey(ctx, created, o); err != nil {
+		return nil, fmt.Errorf("RotateAPIKey: verify new API key: %w", c.deleteAPIKeyOnError(ctx, created.Key, err))
+	}
+	if err := sink.Store(ctx, created); err != nil {
+		return nil, fmt.Errorf("RotateAPIKey: store new API key: %w", c.deleteAPIKeyOnError(ctx, created.Key, err))
+	}
+
+	if o.gracePeriod > 0 {
+		timer := time.NewTimer(o.gracePeriod)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return created, fmt.Errorf("RotateAPIKey: grace period: %w", ctx.Err())
+		}
+	}
+
+	op, err := c.DeleteAPIKey(ctx, old.Key)
+	if err != nil {
+		return created, fmt.Errorf("RotateAPIKey: delete old API key: %w", err)
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return created, fmt.Errorf("RotateAPIKey: delete old API key: %w", err)
+	}
+
+	return created, nil
+}
+
+// verifyAPIKey retries the verification of an API key until it succeeds or
+// the verification timeout elapses.
+func (c Client) verifyAPIKey(ctx context.Context, key *IAMAPIKeyCreated, o *rotateAPIKeyOptions) error {
+	client := c
+	client.credentials = credentials.NewStaticCredentials(key.Key, key.Secret)
+
+	var lastErr error
+	err := c.poll(ctx, "RotateAPIKey", c.waitOptions(WaitOptWithTimeout(o.verifyTimeout)), func(ctx context.Context) (string, any, bool, error) {
+		// Errors are expected while the new API key propagates.
+		if lastErr = o.verify(ctx, &client); lastErr != nil {
+			return lastErr.Error(), nil, false, nil
+		}
+
+		return "verified", key, true, nil
+	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("%w: %w", err, lastErr)
+	}
+
+	return err
+}
+
+// deleteAPIKeyOnError deletes an API key, returning the error causing it
+// joined with the deletion error if any.
+func (c Client) deleteAPIKeyOnError(ctx context.Context, key string, cause error) error {
+	op, err := c.DeleteAPIKey(ctx, key)
+	if err == nil {
+		_, err = c.Wait(ctx, op, OperationStateSuccess)
+	}
+	if err != nil {
+		return fmt.Errorf("%w (deleting the API key: %w)", cause, err)
+	}
+
+	return cause
+}
+
+// APIKeyAge is the age of an API key.
+type APIKeyAge struct {
+	Key       IAMAPIKey
+	CreatedAt time.Time
+	Age       time.Duration
+}
+
+// APIKeyAgeReport reports the API keys to rotate.
+type APIKeyAgeReport struct {
+	// Expired lists the API keys older than the maximum age, oldest first.
+	Expired []APIKeyAge
+	// Unknown lists the API keys of unknown age, not created by RotateAPIKey.
+	// Their age can't be checked against the maximum age, however old they are.
+	Unknown []IAMAPIKey
+}
+
+// ReportAPIKeyAge returns the API keys older than a maximum age, e.g.
+// 90 * 24 * time.Hour, and those whose age is unknown.
+//
+// The API doesn't expose the creation time of the API keys: their age is only
+// known for those created by RotateAPIKey, which records it in their name. All
+// the other ones, such as the API keys created before adopting RotateAPIKey,
+// are listed as Unknown and never as Expired, until rotated once.
+func (c Client) ReportAPIKeyAge(ctx context.Context, maxAge time.Duration) (*APIKeyAgeReport, error) {
+	keys, err := c.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ReportAPIKeyAge: %w", err)
+	}
+
+	now := time.Now()
+	report := &APIKeyAgeReport{}
+	for _, key := range keys.APIKeys {
+		createdAt, ok := APIKeyCreatedAt(key)
+		if !ok {
+			report.Unknown = append(report.Unknown, key)
+			continue
+		}
+
+		if age := now.Sub(createdAt); age > maxAge {
+			report.Expired = append(report.Expired, APIKeyAge{Key: key, CreatedAt: createdAt, Age: age})
+		}
+	}
+
+	sort.Slice(report.Expired, func(i, j int) bool {
+		return report.Expired[i].CreatedAt.Before(report.Expired[j].CreatedAt)
+	})
+	sort.Slice(report.Unknown, func(i, j int) bool {
+		return report.Unknown[i].Name < report.Unknown[j].Name
+	})
+
+	return report, nil
+}
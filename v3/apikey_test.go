@@ -0,0 +1,152 @@
+package v3_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/credentials"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+func TestRotateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	op, err := client.CreateIAMRole(ctx, v3.CreateIAMRoleRequest{Name: "ci"})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	roleID := op.Reference.ID
+
+	old, err := client.CreateAPIKey(ctx, v3.CreateAPIKeyRequest{Name: "ci", RoleID: roleID})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	sink := v3.APIKeyFileSink{File: filepath.Join(dir, "credentials")}
+
+	t.Run("VerificationFailure", func(t *testing.T) {
+		_, err := client.RotateAPIKey(ctx, old.Key, sink,
+			v3.RotateAPIKeyOptWithVerification(func(ctx context.Context, client *v3.Client) error {
+				return errors.New("forbidden")
+			}),
+			v3.RotateAPIKeyOptWithVerificationTimeout(50*time.Millisecond),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "forbidden")
+
+		keys, err := client.ListAPIKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []v3.IAMAPIKey{{Key: old.Key, Name: old.Name, RoleID: roleID}}, keys.APIKeys)
+		require.NoFileExists(t, sink.File)
+	})
+
+	created, err := client.RotateAPIKey(ctx, old.Key, sink, v3.RotateAPIKeyOptWithGracePeriod(time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, roleID, created.RoleID)
+	require.True(t, strings.HasPrefix(created.Name, "ci-"))
+
+	createdAt, ok := v3.APIKeyCreatedAt(v3.IAMAPIKey{Name: created.Name})
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now(), createdAt, time.Minute)
+
+	_, err = client.GetAPIKey(ctx, old.Key)
+	require.ErrorIs(t, err, v3.ErrNotFound)
+
+	content, err := os.ReadFile(sink.File)
+	require.NoError(t, err)
+	require.Equal(t, created.Key+"\n"+created.Secret+"\n", string(content))
+
+	rotated, err := v3.NewClient(
+		credentials.NewAPIKeyFileCredentials(sink.File),
+		v3.ClientOptWithEndpoint(s.Endpoint()),
+	)
+	require.NoError(t, err)
+	_, err = rotated.ListZones(ctx)
+	require.NoError(t, err)
+
+	// Rotating again replaces the creation time suffix.
+	again, err := client.RotateAPIKey(ctx, created.Key, v3.APIKeySinkFunc(func(ctx context.Context, key *v3.IAMAPIKeyCreated) error {
+		return nil
+	}), v3.RotateAPIKeyOptWithName("ci-20200101T000000Z"))
+	require.NoError(t, err)
+	require.Equal(t, "ci-20200101T000000Z", again.Name)
+
+	legacy, err := client.CreateAPIKey(ctx, v3.CreateAPIKeyRequest{Name: "legacy", RoleID: roleID})
+	require.NoError(t, err)
+	recent, err := client.CreateAPIKey(ctx, v3.CreateAPIKeyRequest{
+		Name:   "web-" + time.Now().UTC().Add(-24*time.Hour).Format(v3.APIKeyTimeFormat),
+		RoleID: roleID,
+	})
+	require.NoError(t, err)
+
+	report, err := client.ReportAPIKeyAge(ctx, 90*24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, report.Expired, 1)
+	require.Equal(t, again.Key, report.Expired[0].Key.Key)
+	require.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), report.Expired[0].CreatedAt)
+	require.Equal(t, []v3.IAMAPIKey{{Key: legacy.Key, Name: "legacy", RoleID: roleID}}, report.Unknown)
+	require.NotContains(t, report.Unknown, v3.IAMAPIKey{Key: recent.Key, Name: recent.Name, RoleID: roleID})
+}
+
+// swappableProvider returns its current credentials, and never expires by itself.
+type swappableProvider struct {
+	value credentials.Value
+}
+
+func (p *swappableProvider) Retrieve() (credentials.Value, error) {
+	return p.value, nil
+}
+
+func (p *swappableProvider) IsExpired() bool {
+	return false
+}
+
+func TestClientCredentialsRotation(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	admin, err := s.Client()
+	require.NoError(t, err)
+
+	op, err := admin.CreateIAMRole(ctx, v3.CreateIAMRoleRequest{Name: "ci"})
+	require.NoError(t, err)
+	op, err = admin.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+
+	old, err := admin.CreateAPIKey(ctx, v3.CreateAPIKeyRequest{Name: "old", RoleID: op.Reference.ID})
+	require.NoError(t, err)
+
+	provider := &swappableProvider{value: credentials.Value{APIKey: old.Key, APISecret: old.Secret}}
+	client, err := v3.NewClient(credentials.NewCredentials(provider), v3.ClientOptWithEndpoint(s.Endpoint()))
+	require.NoError(t, err)
+	_, err = client.ListZones(ctx)
+	require.NoError(t, err)
+
+	// The key is rotated behind the client's back: the server rejects the
+	// stale key, and the client retries with the fresh one.
+	created, err := admin.CreateAPIKey(ctx, v3.CreateAPIKeyRequest{Name: "new", RoleID: op.Reference.ID})
+	require.NoError(t, err)
+	op, err = admin.DeleteAPIKey(ctx, old.Key)
+	require.NoError(t, err)
+	_, err = admin.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	provider.value = credentials.Value{APIKey: created.Key, APISecret: created.Secret}
+
+	_, err = client.ListZones(ctx)
+	require.NoError(t, err)
+}
@@ -6,12 +6,13 @@ package v3
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"runtime"
 	"time"
 
-	"github.com/sauterp/egoscale/v3/credentials"
 	"github.com/go-playground/validator/v10"
+	"github.com/sauterp/egoscale/v3/credentials"
 )
 
 // Endpoint represents a zone endpoint.
@@ -57,13 +58,16 @@ func (c Client) GetZoneAPIEndpoint(ctx context.Context, zoneName ZoneName) (Endp
 
 // Client represents an Exoscale API client.
 type Client struct {
-	apiKey          string
-	apiSecret       string
+	credentials     *credentials.Credentials
 	serverEndpoint  string
 	httpClient      *http.Client
 	pollingInterval time.Duration
 	validate        *validator.Validate
 	trace           bool
+	retryPolicy     *RetryPolicy
+	instrumentation Instrumentation
+	logger          *requestLogger
+	rateLimiter     *rateLimiter
 
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
@@ -82,6 +86,10 @@ var UserAgent = fmt.Sprintf("egoscale/%s (%s; %s/%s)",
 
 const pollingInterval = 3 * time.Second
 
+// paginationTimeWindow is the time range requested per call by iterators over
+// operations filtering results by time range.
+const paginationTimeWindow = 24 * time.Hour
+
 // ClientOpt represents a function setting Exoscale API client option.
 type ClientOpt func(*Client) error
 
@@ -93,6 +101,69 @@ func ClientOptWithTrace() ClientOpt {
 	}
 }
 
+// ClientOptWithRetryPolicy returns a ClientOpt enabling automatic retries of
+// failed HTTP requests according to the given RetryPolicy.
+func ClientOptWithRetryPolicy(policy RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		if policy.MaxRetries < 0 {
+			return fmt.Errorf("invalid retry policy: negative max retries")
+		}
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// ClientOptWithRateLimit returns a ClientOpt limiting the rate and the
+// concurrency of the API requests. When the API throttles a request with an
+// HTTP 429 response, the requests of the same class to the same endpoint are
+// paused for the delay advertised by the Retry-After header.
+func ClientOptWithRateLimit(limit RateLimit) ClientOpt {
+	return func(c *Client) error {
+		if limit.RequestsPerSecond < 0 || limit.MutationsPerSecond < 0 {
+			return fmt.Errorf("invalid rate limit: negative rate")
+		}
+		if limit.Burst < 0 || limit.MutationBurst < 0 || limit.MaxInFlight < 0 || limit.ThrottleDelay < 0 {
+			return fmt.Errorf("invalid rate limit: negative value")
+		}
+		c.rateLimiter = newRateLimiter(limit)
+		return nil
+	}
+}
+
+// ClientOptWithInstrumentation returns a ClientOpt observing the API calls
+// and waits with the given Instrumentation.
+func ClientOptWithInstrumentation(i Instrumentation) ClientOpt {
+	return func(c *Client) error {
+		c.instrumentation = i
+		return nil
+	}
+}
+
+// ClientOptWithLogger returns a ClientOpt logging the API requests with the given
+// logger: operation, method, URL, status and duration. The Authorization header is
+// never logged, and the sensitive fields of the bodies are redacted if logged.
+func ClientOptWithLogger(logger *slog.Logger, opts ...LoggerOpt) ClientOpt {
+	return func(c *Client) error {
+		if logger == nil {
+			return fmt.Errorf("nil logger")
+		}
+		c.logger = newRequestLogger(logger, opts...)
+		return nil
+	}
+}
+
+// ClientOptWithPollingInterval returns a ClientOpt overriding the maximum interval
+// between two polls when waiting for async operations or resources.
+func ClientOptWithPollingInterval(interval time.Duration) ClientOpt {
+	return func(c *Client) error {
+		if interval <= 0 {
+			return fmt.Errorf("invalid polling interval: %s", interval)
+		}
+		c.pollingInterval = interval
+		return nil
+	}
+}
+
 // ClientOptWithValidator returns a ClientOpt with a given validator.
 func ClientOptWithValidator(validate *validator.Validate) ClientOpt {
 	return func(c *Client) error {
@@ -132,15 +203,15 @@ func ClientOptWithHTTPClient(v *http.Client) ClientOpt {
 }
 
 // NewClient returns a new Exoscale API client.
+// The credentials are retrieved when signing requests, so the client picks up
+// renewed credentials once the previous ones expire.
 func NewClient(credentials *credentials.Credentials, opts ...ClientOpt) (*Client, error) {
-	values, err := credentials.Get()
-	if err != nil {
+	if _, err := credentials.Get(); err != nil {
 		return nil, err
 	}
 
 	client := &Client{
-		apiKey:          values.APIKey,
-		apiSecret:       values.APISecret,
+		credentials:     credentials,
 		serverEndpoint:  string(CHGva2),
 		httpClient:      http.DefaultClient,
 		pollingInterval: pollingInterval,
@@ -159,56 +230,68 @@ func NewClient(credentials *credentials.Credentials, opts ...ClientOpt) (*Client
 // WithEndpoint returns a copy of Client with new zone Endpoint.
 func (c *Client) WithEndpoint(endpoint Endpoint) *Client {
 	return &Client{
-		apiKey:              c.apiKey,
-		apiSecret:           c.apiSecret,
+		credentials:         c.credentials,
 		serverEndpoint:      string(endpoint),
 		httpClient:          c.httpClient,
 		requestInterceptors: c.requestInterceptors,
 		pollingInterval:     c.pollingInterval,
 		trace:               c.trace,
 		validate:            c.validate,
+		retryPolicy:         c.retryPolicy,
+		instrumentation:     c.instrumentation,
+		logger:              c.logger,
+		rateLimiter:         c.rateLimiter,
 	}
 }
 
 // WithTrace returns a copy of Client with tracing enabled.
 func (c *Client) WithTrace() *Client {
 	return &Client{
-		apiKey:              c.apiKey,
-		apiSecret:           c.apiSecret,
+		credentials:         c.credentials,
 		serverEndpoint:      c.serverEndpoint,
 		httpClient:          c.httpClient,
 		requestInterceptors: c.requestInterceptors,
 		pollingInterval:     c.pollingInterval,
 		trace:               true,
 		validate:            c.validate,
+		retryPolicy:         c.retryPolicy,
+		instrumentation:     c.instrumentation,
+		logger:              c.logger,
+		rateLimiter:         c.rateLimiter,
 	}
 }
 
 // WithHttpClient returns a copy of Client with new http.Client.
 func (c *Client) WithHttpClient(client *http.Client) *Client {
 	return &Client{
-		apiKey:              c.apiKey,
-		apiSecret:           c.apiSecret,
+		credentials:         c.credentials,
 		serverEndpoint:      c.serverEndpoint,
 		httpClient:          client,
 		requestInterceptors: c.requestInterceptors,
 		pollingInterval:     c.pollingInterval,
 		trace:               c.trace,
 		validate:            c.validate,
+		retryPolicy:         c.retryPolicy,
+		instrumentation:     c.instrumentation,
+		logger:              c.logger,
+		rateLimiter:         c.rateLimiter,
 	}
 }
 
 // WithRequestInterceptor returns a copy of Client with new RequestInterceptors.
 func (c *Client) WithRequestInterceptor(f ...RequestInterceptorFn) *Client {
 	return &Client{
-		apiKey:              c.apiKey,
-		apiSecret:           c.apiSecret,
+		credentials:         c.credentials,
 		serverEndpoint:      c.serverEndpoint,
 		httpClient:          c.httpClient,
 		requestInterceptors: append(c.requestInterceptors, f...),
 		pollingInterval:     c.pollingInterval,
 		trace:               c.trace,
 		validate:            c.validate,
+		retryPolicy:         c.retryPolicy,
+		instrumentation:     c.instrumentation,
+		logger:              c.logger,
+		rateLimiter:         c.rateLimiter,
 	}
 }
 
@@ -0,0 +1,120 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+// Environment variables overriding the settings of the configuration file.
+const (
+	// EnvZone is the environment variable overriding the default zone.
+	EnvZone = "EXOSCALE_ZONE"
+	// EnvAPIEndpoint is the environment variable overriding the API endpoint.
+	EnvAPIEndpoint = "EXOSCALE_API_ENDPOINT"
+	// EnvAPIEnvironment is the environment variable overriding the API environment.
+	EnvAPIEnvironment = "EXOSCALE_API_ENVIRONMENT"
+)
+
+const (
+	defaultEnvironment = "api"
+	defaultZone        = ZoneName("ch-gva-2")
+)
+
+// ZoneEndpoint returns the API endpoint of a zone in the given API environment,
+// "api" being the production environment.
+func ZoneEndpoint(environment string, zone ZoneName) Endpoint {
+	if environment == "" {
+		environment = defaultEnvironment
+	}
+
+	return Endpoint(fmt.Sprintf("https://%s-%s.exoscale.com/v2", environment, zone))
+}
+
+// NewClientFromConfig returns a new Exoscale API client configured from an
+// account of the exo CLI configuration file, read by the given FileProvider.
+//
+// The API credentials are read from the environment (see credentials.EnvProvider),
+// then from the account. The API endpoint is, by order of precedence:
+// EXOSCALE_API_ENDPOINT, the account custom endpoint if it is a v2 endpoint, or
+// the endpoint of the zone set by EXOSCALE_ZONE or the account default zone, in
+// the API environment set by EXOSCALE_API_ENVIRONMENT or the account environment.
+// A legacy v1 endpoint of the account, e.g. "https://api.exoscale.com/v1", only
+// sets the API environment if the account has none.
+// The account client timeout (in minutes) and custom headers are applied too.
+// opts are applied last and can override any of these settings.
+func NewClientFromConfig(provider *credentials.FileProvider, opts ...ClientOpt) (*Client, error) {
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvProvider{},
+		provider,
+	})
+
+	account, err := provider.Account()
+	if err != nil {
+		// The configuration file is optional when the credentials are set in the environment.
+		if _, envErr := (&credentials.EnvProvider{}).Retrieve(); envErr != nil {
+			return nil, fmt.Errorf("new client from config: %w", err)
+		}
+	}
+
+	configOpts := []ClientOpt{ClientOptWithEndpoint(configEndpoint(account))}
+	if account.ClientTimeout > 0 {
+		configOpts = append(configOpts, ClientOptWithHTTPClient(&http.Client{
+			Timeout: time.Duration(account.ClientTimeout) * time.Minute,
+		}))
+	}
+	if len(account.CustomHeaders) > 0 {
+		headers := account.CustomHeaders
+		configOpts = append(configOpts, ClientOptWithRequestInterceptors(func(ctx context.Context, req *http.Request) error {
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+			return nil
+		}))
+	}
+
+	return NewClient(creds, append(configOpts, opts...)...)
+}
+
+// configEndpoint returns the API endpoint to use according to the environment
+// and the configuration file account.
+func configEndpoint(account credentials.Account) Endpoint {
+	if endpoint := os.Getenv(EnvAPIEndpoint); endpoint != "" {
+		return Endpoint(endpoint)
+	}
+
+	environment := account.Environment
+	if account.Endpoint != "" {
+		u, err := url.Parse(account.Endpoint)
+		switch {
+		case err != nil:
+		case strings.TrimSuffix(u.Path, "/") == "/v2":
+			return Endpoint(account.Endpoint)
+		// The exo CLI configuration may hold the legacy v1 endpoint of an API
+		// environment, e.g. "https://api.exoscale.com/v1", which only tells
+		// the environment of the zone endpoint.
+		case environment == "" && strings.HasSuffix(u.Hostname(), ".exoscale.com"):
+			environment = strings.TrimSuffix(u.Hostname(), ".exoscale.com")
+		}
+	}
+
+	zone := ZoneName(account.DefaultZone)
+	if z := os.Getenv(EnvZone); z != "" {
+		zone = ZoneName(z)
+	}
+	if zone == "" {
+		zone = defaultZone
+	}
+
+	if env := os.Getenv(EnvAPIEnvironment); env != "" {
+		environment = env
+	}
+
+	return ZoneEndpoint(environment, zone)
+}
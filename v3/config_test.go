@@ -0,0 +1,111 @@
+package v3
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+const testConfig = `
+defaultAccount = "prod"
+
+[[accounts]]
+name = "prod"
+key = "EXOprod"
+secret = "prod-secret"
+defaultZone = "de-fra-1"
+
+[[accounts]]
+name = "staging"
+key = "EXOstaging"
+secret = "staging-secret"
+environment = "ppapi"
+defaultZone = "ch-dk-2"
+defaultSSHKey = "deploy"
+clientTimeout = 5
+
+[[accounts]]
+name = "legacy"
+key = "EXOlegacy"
+secret = "legacy-secret"
+endpoint = "https://ppapi.exoscale.com/v1"
+defaultZone = "ch-dk-2"
+
+[[accounts]]
+name = "custom"
+key = "EXOcustom"
+secret = "custom-secret"
+endpoint = "http://localhost:9090/v2"
+defaultZone = "de-fra-1"
+`
+
+func TestNewClientFromConfig(t *testing.T) {
+	t.Setenv("EXOSCALE_API_KEY", "")
+	t.Setenv("EXOSCALE_API_SECRET", "")
+	t.Setenv(EnvZone, "")
+	t.Setenv(EnvAPIEndpoint, "")
+	t.Setenv(EnvAPIEnvironment, "")
+
+	filename := filepath.Join(t.TempDir(), "exoscale.toml")
+	require.NoError(t, os.WriteFile(filename, []byte(testConfig), 0o600))
+
+	client, err := NewClientFromConfig(credentials.NewFileProvider(credentials.FileOptWithFilename(filename)))
+	require.NoError(t, err)
+	requireAPIKey(t, client, "EXOprod")
+	require.Equal(t, string(DEFra1), client.serverEndpoint)
+
+	provider := credentials.NewFileProvider(
+		credentials.FileOptWithFilename(filename),
+		credentials.FileOptWithAccount("staging"),
+	)
+	account, err := provider.Account()
+	require.NoError(t, err)
+	require.Equal(t, "deploy", account.DefaultSSHKey)
+
+	client, err = NewClientFromConfig(provider)
+	require.NoError(t, err)
+	requireAPIKey(t, client, "EXOstaging")
+	require.Equal(t, "https://ppapi-ch-dk-2.exoscale.com/v2", client.serverEndpoint)
+	require.Equal(t, "5m0s", client.httpClient.Timeout.String())
+
+	// A legacy v1 endpoint only sets the API environment.
+	client, err = NewClientFromConfig(credentials.NewFileProvider(
+		credentials.FileOptWithFilename(filename),
+		credentials.FileOptWithAccount("legacy"),
+	))
+	require.NoError(t, err)
+	require.Equal(t, "https://ppapi-ch-dk-2.exoscale.com/v2", client.serverEndpoint)
+
+	client, err = NewClientFromConfig(credentials.NewFileProvider(
+		credentials.FileOptWithFilename(filename),
+		credentials.FileOptWithAccount("custom"),
+	))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:9090/v2", client.serverEndpoint)
+
+	t.Setenv(EnvZone, "at-vie-1")
+	t.Setenv(EnvAPIEnvironment, "api")
+	client, err = NewClientFromConfig(provider)
+	require.NoError(t, err)
+	require.Equal(t, string(ATVie1), client.serverEndpoint)
+
+	t.Setenv(EnvAPIEndpoint, "http://localhost:8080/v2")
+	t.Setenv("EXOSCALE_API_KEY", "EXOenv")
+	t.Setenv("EXOSCALE_API_SECRET", "env-secret")
+	client, err = NewClientFromConfig(credentials.NewFileProvider(credentials.FileOptWithFilename(filepath.Join(t.TempDir(), "missing.toml"))))
+	require.NoError(t, err)
+	requireAPIKey(t, client, "EXOenv")
+	require.Equal(t, "http://localhost:8080/v2", client.serverEndpoint)
+}
+
+func requireAPIKey(t *testing.T, client *Client, apiKey string) {
+	t.Helper()
+
+	creds, err := client.credentials.Get()
+	require.NoError(t, err)
+	require.Equal(t, apiKey, creds.APIKey)
+}
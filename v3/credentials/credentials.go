@@ -67,6 +67,28 @@ func (c *Credentials) Get() (Value, error) {
 	return c.credentials, nil
 }
 
+// Refresh retrieves the credentials again if they are still the stale ones,
+// and returns the current credentials otherwise: concurrent callers holding
+// the same stale credentials cause a single retrieval.
+func (c *Credentials) Refresh(stale Value) (Value, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.credentials == stale {
+		v, err := c.provider.Retrieve()
+		if err != nil {
+			return Value{}, err
+		}
+		c.credentials = v
+	}
+
+	if !c.credentials.IsSet() {
+		return Value{}, ErrMissingIncomplete
+	}
+
+	return c.credentials, nil
+}
+
 func (c *Credentials) IsExpired() bool {
 	c.RLock()
 	defer c.RUnlock()
@@ -74,10 +96,16 @@ func (c *Credentials) IsExpired() bool {
 	return (!c.credentials.IsSet() || c.provider.IsExpired())
 }
 
+// retrieve retrieves the credentials from the provider, unless a concurrent
+// call already did since they were found expired.
 func (c *Credentials) retrieve() error {
 	c.Lock()
 	defer c.Unlock()
 
+	if c.credentials.IsSet() && !c.provider.IsExpired() {
+		return nil
+	}
+
 	v, err := c.provider.Retrieve()
 	if err != nil {
 		return err
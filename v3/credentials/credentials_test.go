@@ -0,0 +1,133 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessProvider(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	p := NewProcessProvider(
+		[]string{"sh", "-c", `echo '{"key":"EXOkey","secret":"s3cr3t","expiration":"2024-06-01T13:00:00Z"}'`},
+		ProcessOptWithExpiryWindow(5*time.Minute),
+	)
+	p.now = func() time.Time { return now }
+	require.True(t, p.IsExpired())
+
+	v, err := p.Retrieve()
+	require.NoError(t, err)
+	require.Equal(t, Value{APIKey: "EXOkey", APISecret: "s3cr3t"}, v)
+	require.False(t, p.IsExpired())
+
+	now = now.Add(56 * time.Minute)
+	require.True(t, p.IsExpired())
+
+	_, err = NewProcessProvider([]string{"sh", "-c", "echo denied >&2; exit 1"}).Retrieve()
+	require.ErrorContains(t, err, "denied")
+}
+
+func TestProcessCredentialsConcurrent(t *testing.T) {
+	const callers = 10
+
+	dir := t.TempDir()
+	runs := filepath.Join(dir, "runs")
+	output := filepath.Join(dir, "output")
+	require.NoError(t, os.WriteFile(output, []byte(`{"key":"EXOkey","secret":"s3cr3t","expiration":"2024-06-01T13:00:00Z"}`), 0o600))
+
+	p := NewProcessProvider([]string{"sh", "-c", `echo run >> "$0"; cat "$1"`, runs, output})
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+	creds := NewCredentials(p)
+
+	_, err := creds.Get()
+	require.NoError(t, err)
+
+	// The callers all find the credentials expired before any retrieves them.
+	require.NoError(t, os.WriteFile(output, []byte(`{"key":"EXOkey","secret":"s3cr3t","expiration":"2024-06-01T14:00:00Z"}`), 0o600))
+	var (
+		arrived atomic.Int32
+		barrier = make(chan struct{})
+	)
+	p.now = func() time.Time {
+		if n := arrived.Add(1); n == callers {
+			close(barrier)
+		} else if n < callers {
+			<-barrier
+		}
+		return now.Add(time.Hour)
+	}
+
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := creds.Get()
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	content, err := os.ReadFile(runs)
+	require.NoError(t, err)
+	require.Equal(t, "run\nrun\n", string(content))
+}
+
+func TestSecretsFileProvider(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "api-key")
+	secretFile := filepath.Join(dir, "api-secret")
+	require.NoError(t, os.WriteFile(keyFile, []byte("EXOold\n"), 0o600))
+	require.NoError(t, os.WriteFile(secretFile, []byte("old-secret\n"), 0o600))
+
+	creds := NewSecretsFileCredentials(keyFile, secretFile)
+
+	v, err := creds.Get()
+	require.NoError(t, err)
+	require.Equal(t, Value{APIKey: "EXOold", APISecret: "old-secret"}, v)
+	require.False(t, creds.IsExpired())
+
+	// Rotated credentials have the same length, and the files may keep their
+	// modification time.
+	info, err := os.Stat(keyFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, []byte("EXOnew\n"), 0o600))
+	require.NoError(t, os.WriteFile(secretFile, []byte("new-secret\n"), 0o600))
+	require.NoError(t, os.Chtimes(keyFile, info.ModTime(), info.ModTime()))
+	require.NoError(t, os.Chtimes(secretFile, info.ModTime(), info.ModTime()))
+	require.True(t, creds.IsExpired())
+
+	v, err = creds.Get()
+	require.NoError(t, err)
+	require.Equal(t, Value{APIKey: "EXOnew", APISecret: "new-secret"}, v)
+}
+
+func TestAPIKeyFileProvider(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(file, []byte("EXOold\nold-secret\n"), 0o600))
+
+	creds := NewAPIKeyFileCredentials(file)
+
+	v, err := creds.Get()
+	require.NoError(t, err)
+	require.Equal(t, Value{APIKey: "EXOold", APISecret: "old-secret"}, v)
+	require.False(t, creds.IsExpired())
+
+	require.NoError(t, os.WriteFile(file, []byte("EXOnew\nnew-secret\n"), 0o600))
+	require.True(t, creds.IsExpired())
+
+	v, err = creds.Get()
+	require.NoError(t, err)
+	require.Equal(t, Value{APIKey: "EXOnew", APISecret: "new-secret"}, v)
+
+	require.NoError(t, os.WriteFile(file, []byte("EXOkey\n"), 0o600))
+	_, err = NewAPIKeyFileProvider(file).Retrieve()
+	require.ErrorIs(t, err, ErrMissingIncomplete)
+}
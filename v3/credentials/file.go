@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"slices"
 
 	"github.com/spf13/viper"
 )
@@ -25,45 +26,84 @@ func FileOptWithAccount(account string) FileOpt {
 	}
 }
 
+// A FileProvider retrieves credentials from an account of the exo CLI
+// configuration file, and exposes the other settings of the account.
 type FileProvider struct {
 	filename  string
 	account   string
 	retrieved bool
 
-	// TODO: export some fields from the config like: default zone...etc.
+	config *Account
 }
 
-func NewFileCredentials(opts ...FileOpt) *Credentials {
+// NewFileProvider returns a FileProvider reading the exo CLI configuration file.
+func NewFileProvider(opts ...FileOpt) *FileProvider {
 	fp := &FileProvider{}
 	for _, opt := range opts {
 		opt(fp)
 	}
-	return NewCredentials(fp)
+	return fp
+}
+
+func NewFileCredentials(opts ...FileOpt) *Credentials {
+	return NewCredentials(NewFileProvider(opts...))
 }
 
 func (f *FileProvider) Retrieve() (Value, error) {
 	f.retrieved = false
 
-	viperConf, err := f.retrieveViperConfig()
+	account, err := f.load()
 	if err != nil {
 		return Value{}, err
 	}
 
+	v := Value{
+		APIKey:    account.Key,
+		APISecret: account.Secret,
+	}
+
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("file provider: account %q: %w", account.Name, ErrMissingIncomplete)
+	}
+
+	f.retrieved = true
+
+	return v, nil
+}
+
+// Account returns the account selected in the configuration file, holding
+// the settings such as the default zone or the API environment.
+// The configuration file is read on the first call.
+func (f *FileProvider) Account() (Account, error) {
+	if f.config != nil {
+		return *f.config, nil
+	}
+
+	return f.load()
+}
+
+// load reads the configuration file and returns the selected account.
+func (f *FileProvider) load() (Account, error) {
+	viperConf, err := f.retrieveViperConfig()
+	if err != nil {
+		return Account{}, err
+	}
+
 	if err := viperConf.ReadInConfig(); err != nil {
-		return Value{}, err
+		return Account{}, err
 	}
 
 	config := Config{}
 	if err := viperConf.Unmarshal(&config); err != nil {
-		return Value{}, fmt.Errorf("file provider: couldn't read config: %w", err)
+		return Account{}, fmt.Errorf("file provider: couldn't read config: %w", err)
 	}
 
 	if len(config.Accounts) == 0 {
-		return Value{}, fmt.Errorf("file provider: no accounts were found into %q", viper.ConfigFileUsed())
+		return Account{}, fmt.Errorf("file provider: no accounts were found into %q", viperConf.ConfigFileUsed())
 	}
 
 	if f.account == "" && config.DefaultAccount == "" {
-		return Value{}, fmt.Errorf("file provider: no account defined")
+		return Account{}, fmt.Errorf("file provider: no account defined")
 	}
 
 	accountName := config.DefaultAccount
@@ -71,26 +111,14 @@ func (f *FileProvider) Retrieve() (Value, error) {
 		accountName = f.account
 	}
 
-	account := Account{}
-	for i, a := range config.Accounts {
-		if a.Name == accountName {
-			account = config.Accounts[i]
-			break
-		}
+	idx := slices.IndexFunc(config.Accounts, func(a Account) bool { return a.Name == accountName })
+	if idx < 0 {
+		return Account{}, fmt.Errorf("file provider: account %q not found", accountName)
 	}
+	account := config.Accounts[idx]
+	f.config = &account
 
-	v := Value{
-		APIKey:    account.Key,
-		APISecret: account.Secret,
-	}
-
-	if !v.IsSet() {
-		return Value{}, fmt.Errorf("file provider: account %q: %w", accountName, ErrMissingIncomplete)
-	}
-
-	f.retrieved = true
-
-	return v, nil
+	return account, nil
 }
 
 // IsExpired returns if the shared credentials have expired.
@@ -98,9 +126,12 @@ func (f *FileProvider) IsExpired() bool {
 	return !f.retrieved
 }
 
+// Account represents an account of the exo CLI configuration file.
 type Account struct {
-	Name                string
-	Account             string
+	Name    string
+	Account string
+	// Endpoint is a custom API endpoint, overriding the zone endpoint.
+	Endpoint            string
 	SosEndpoint         string
 	Environment         string
 	Key                 string
@@ -114,6 +145,7 @@ type Account struct {
 	CustomHeaders       map[string]string
 }
 
+// Config represents the exo CLI configuration file.
 type Config struct {
 	DefaultAccount      string
 	DefaultOutputFormat string
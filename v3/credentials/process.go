@@ -0,0 +1,129 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+const defaultProcessTimeout = time.Minute
+
+// ProcessOutput represents the JSON document a ProcessProvider command must
+// print on its standard output.
+type ProcessOutput struct {
+	Key    string `json:"key"`
+	Secret string `json:"secret"`
+	// Expiration is the optional expiration date of the credentials.
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+type ProcessOpt func(*ProcessProvider)
+
+// ProcessOptWithTimeout returns a ProcessOpt overriding the default command
+// timeout of 1 minute.
+func ProcessOptWithTimeout(timeout time.Duration) ProcessOpt {
+	return func(p *ProcessProvider) {
+		p.timeout = timeout
+	}
+}
+
+// ProcessOptWithExpiryWindow returns a ProcessOpt making the credentials expire
+// the given duration before their actual expiration, to renew them early.
+func ProcessOptWithExpiryWindow(window time.Duration) ProcessOpt {
+	return func(p *ProcessProvider) {
+		p.expiryWindow = window
+	}
+}
+
+// A ProcessProvider retrieves credentials from the output of an external
+// command, printing a ProcessOutput JSON document.
+type ProcessProvider struct {
+	command      []string
+	timeout      time.Duration
+	expiryWindow time.Duration
+
+	expiration time.Time
+	retrieved  bool
+
+	// now is overridden in tests.
+	now func() time.Time
+}
+
+// NewProcessProvider returns a ProcessProvider running the given command
+// with its arguments.
+func NewProcessProvider(command []string, opts ...ProcessOpt) *ProcessProvider {
+	p := &ProcessProvider{
+		command: append([]string{}, command...),
+		timeout: defaultProcessTimeout,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func NewProcessCredentials(command []string, opts ...ProcessOpt) *Credentials {
+	return NewCredentials(NewProcessProvider(command, opts...))
+}
+
+// Retrieve runs the command and parses its output.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	p.retrieved = false
+
+	if len(p.command) == 0 {
+		return Value{}, fmt.Errorf("process provider: no command")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.command[0], p.command[1:]...) // nolint:gosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := bytes.TrimSpace(stderr.Bytes()); len(msg) > 0 {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+		return Value{}, fmt.Errorf("process provider: command %q: %w", p.command[0], err)
+	}
+
+	var output ProcessOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return Value{}, fmt.Errorf("process provider: invalid command output: %w", err)
+	}
+
+	v := Value{
+		APIKey:    output.Key,
+		APISecret: output.Secret,
+	}
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("process provider: %w", ErrMissingIncomplete)
+	}
+
+	p.expiration = time.Time{}
+	if output.Expiration != nil {
+		if !output.Expiration.After(p.now()) {
+			return Value{}, fmt.Errorf("process provider: command returned expired credentials")
+		}
+		p.expiration = *output.Expiration
+	}
+
+	p.retrieved = true
+
+	return v, nil
+}
+
+// IsExpired returns if the credentials have not been retrieved yet or
+// have reached their expiration, minus the expiry window.
+func (p *ProcessProvider) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+
+	return !p.expiration.IsZero() && !p.now().Add(p.expiryWindow).Before(p.expiration)
+}
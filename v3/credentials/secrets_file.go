@@ -0,0 +1,104 @@
+package credentials
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// A SecretsFileProvider retrieves credentials from files holding the API key
+// and secret, such as Kubernetes Secrets or Vault Agent templates mounted in a
+// container, or from a single file holding both. The credentials expire when
+// a file changes, so rotated credentials are picked up on the next retrieval.
+type SecretsFileProvider struct {
+	// files are either the key and secret files, or the single file holding
+	// the key on its first line and the secret on its second one.
+	files []string
+
+	retrieved bool
+	// sums are the checksums of the file contents, since a rotated key or
+	// secret has the same size and may have the same modification time.
+	sums [][sha256.Size]byte
+}
+
+// NewSecretsFileProvider returns a SecretsFileProvider reading the API key and
+// secret from the given files.
+func NewSecretsFileProvider(keyFile, secretFile string) *SecretsFileProvider {
+	return &SecretsFileProvider{
+		files: []string{keyFile, secretFile},
+	}
+}
+
+func NewSecretsFileCredentials(keyFile, secretFile string) *Credentials {
+	return NewCredentials(NewSecretsFileProvider(keyFile, secretFile))
+}
+
+// NewAPIKeyFileProvider returns a SecretsFileProvider reading the API key and
+// secret from the first two lines of a single file, such as written by
+// v3.APIKeyFileSink. Unlike separate files, a single file replaced atomically
+// never exposes a key along with the secret of another one.
+func NewAPIKeyFileProvider(file string) *SecretsFileProvider {
+	return &SecretsFileProvider{
+		files: []string{file},
+	}
+}
+
+func NewAPIKeyFileCredentials(file string) *Credentials {
+	return NewCredentials(NewAPIKeyFileProvider(file))
+}
+
+// Retrieve reads the API key and secret files.
+func (s *SecretsFileProvider) Retrieve() (Value, error) {
+	s.retrieved = false
+
+	var (
+		values []string
+		sums   = make([][sha256.Size]byte, len(s.files))
+	)
+	for i, file := range s.files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return Value{}, fmt.Errorf("secrets file provider: %w", err)
+		}
+
+		values = append(values, strings.TrimSpace(string(content)))
+		sums[i] = sha256.Sum256(content)
+	}
+	if len(s.files) == 1 {
+		key, secret, _ := strings.Cut(values[0], "\n")
+		values = []string{strings.TrimSpace(key), strings.TrimSpace(secret)}
+	}
+
+	v := Value{
+		APIKey:    values[0],
+		APISecret: values[1],
+	}
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("secrets file provider: %w", ErrMissingIncomplete)
+	}
+
+	s.sums = sums
+	s.retrieved = true
+
+	return v, nil
+}
+
+// IsExpired returns if the credentials have not been retrieved yet, or if
+// a file changed since.
+func (s *SecretsFileProvider) IsExpired() bool {
+	if !s.retrieved {
+		return true
+	}
+
+	for i, file := range s.files {
+		// os.ReadFile follows symbolic links, which Kubernetes swaps on Secret
+		// updates.
+		content, err := os.ReadFile(file)
+		if err != nil || sha256.Sum256(content) != s.sums[i] {
+			return true
+		}
+	}
+
+	return false
+}
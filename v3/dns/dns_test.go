@@ -0,0 +1,140 @@
+package dns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+const testZoneFile = `$ORIGIN example.net.
+$TTL 1h
+@	IN	SOA	ns1.other-provider.com. hostmaster.example.net. (
+		2024010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+	IN	NS	ns1.other-provider.com.
+	IN	MX	10 mail
+	IN	MX	20 mail.backup.example.org.
+	300	IN	A	192.0.2.1
+	IN	TXT	"v=spf1 include:_spf.example.org " "-all"
+www	IN	CNAME	@
+api	60	A	192.0.2.10
+	60	A	192.0.2.11
+_sip._tcp	IN	SRV	5 10 5060 sip
+$ORIGIN dev.example.net.
+app		AAAA	2001:db8::1
+`
+
+func TestParse(t *testing.T) {
+	records, err := Parse(strings.NewReader(testZoneFile), "example.net")
+	require.NoError(t, err)
+
+	require.Equal(t, []v3.DNSDomainRecord{
+		{Type: "SOA", Ttl: 3600, Content: "ns1.other-provider.com. hostmaster.example.net. 2024010101 7200 3600 1209600 3600"},
+		{Type: "NS", Ttl: 3600, Content: "ns1.other-provider.com"},
+		{Type: "MX", Ttl: 3600, Priority: 10, Content: "mail.example.net"},
+		{Type: "MX", Ttl: 3600, Priority: 20, Content: "mail.backup.example.org"},
+		{Type: "A", Ttl: 300, Content: "192.0.2.1"},
+		{Type: "TXT", Ttl: 3600, Content: "v=spf1 include:_spf.example.org -all"},
+		{Name: "www", Type: "CNAME", Ttl: 3600, Content: "example.net"},
+		{Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.10"},
+		{Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.11"},
+		{Name: "_sip._tcp", Type: "SRV", Ttl: 3600, Priority: 5, Content: "10 5060 sip.example.net"},
+		{Name: "app.dev", Type: "AAAA", Ttl: 3600, Content: "2001:db8::1"},
+	}, records)
+
+	for _, zone := range []string{
+		"www.example.org. IN A 192.0.2.1",
+		"\tIN A 192.0.2.1",
+		"www IN MX mail",
+		"www IN A (192.0.2.1",
+		"www 1x IN A 192.0.2.1",
+		"$INCLUDE other.zone",
+	} {
+		_, err := Parse(strings.NewReader(zone), "example.net")
+		require.Error(t, err, zone)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	current := []v3.DNSDomainRecord{
+		{ID: "soa", Type: "SOA", Content: "ns1.exoscale.ch. support.exoscale.ch. 1 10800 3600 604800 3600"},
+		{ID: "ns", Type: "NS", Content: "ns1.exoscale.ch."},
+		{ID: "a1", Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.10"},
+		{ID: "a2", Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.11"},
+		{ID: "a3", Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.12"},
+		{ID: "mx", Type: "MX", Ttl: 3600, Priority: 10, Content: "mail.example.net."},
+		{ID: "txt", Type: "TXT", Ttl: 3600, Content: `"v=spf1 -all"`},
+		{ID: "old", Name: "old", Type: "CNAME", Ttl: 3600, Content: "example.net"},
+	}
+	desired := []v3.DNSDomainRecord{
+		{Type: "NS", Ttl: 3600, Content: "ns1.other-provider.com"},
+		{Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.11"},
+		{Name: "api", Type: "A", Ttl: 300, Content: "192.0.2.10"},
+		{Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.20"},
+		{Name: "api", Type: "A", Ttl: 60, Content: "192.0.2.21"},
+		{Name: "@", Type: "MX", Ttl: 3600, Priority: 5, Content: "mail.example.net"},
+		{Type: "TXT", Content: "v=spf1 -all"},
+	}
+
+	plan := Diff(current, desired)
+	require.Equal(t, []v3.DNSDomainRecord{desired[4]}, plan.Create)
+	require.Equal(t, []Update{
+		{Current: current[5], Desired: desired[5]},
+		{Current: current[2], Desired: desired[2]},
+		{Current: current[4], Desired: desired[3]},
+	}, plan.Update)
+	require.Equal(t, []v3.DNSDomainRecord{current[7]}, plan.Delete)
+
+	require.True(t, Diff(current, current).Empty())
+}
+
+func TestPlanApply(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	domain, err := client.CreateDNSDomain(ctx, v3.CreateDNSDomainRequest{UnicodeName: "example.net"})
+	require.NoError(t, err)
+
+	desired, err := Parse(strings.NewReader(testZoneFile), "example.net")
+	require.NoError(t, err)
+
+	current, err := client.ListDNSDomainRecords(ctx, domain.ID)
+	require.NoError(t, err)
+
+	plan := Diff(current.DNSDomainRecords, desired)
+	require.Len(t, plan.Create, 9)
+	require.NoError(t, plan.Apply(ctx, client, domain.ID))
+
+	current, err = client.ListDNSDomainRecords(ctx, domain.ID)
+	require.NoError(t, err)
+	require.True(t, Diff(current.DNSDomainRecords, desired).Empty())
+
+	// The zone exported by the API round-trips.
+	zone, err := client.GetDNSDomainZoneFile(ctx, domain.ID)
+	require.NoError(t, err)
+	exported, err := Parse(strings.NewReader(zone.ZoneFile), "example.net")
+	require.NoError(t, err)
+	require.True(t, Diff(current.DNSDomainRecords, exported).Empty())
+
+	desired = desired[:len(desired)-1]
+	plan = Diff(current.DNSDomainRecords, desired)
+	require.Len(t, plan.Delete, 1)
+	require.NoError(t, plan.Apply(ctx, client, domain.ID))
+
+	current, err = client.ListDNSDomainRecords(ctx, domain.ID)
+	require.NoError(t, err)
+	require.True(t, Diff(current.DNSDomainRecords, desired).Empty())
+}
@@ -0,0 +1,246 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// Update is a change of an existing record.
+type Update struct {
+	Current v3.DNSDomainRecord
+	Desired v3.DNSDomainRecord
+}
+
+// Plan represents the changes needed to make the records of a domain match the
+// desired ones.
+type Plan struct {
+	Create []v3.DNSDomainRecord
+	Update []Update
+	Delete []v3.DNSDomainRecord
+}
+
+// Empty returns whether the domain already matches the desired records.
+func (p *Plan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// recordSetKey identifies the set of records sharing a name and a type.
+type recordSetKey struct {
+	name  string
+	rtype v3.DNSDomainRecordType
+}
+
+// Diff computes the changes needed to make the current records of a domain match
+// the desired ones.
+//
+// Records are compared by set of records sharing a name and a type, so that
+// multi-value records (e.g. several A or MX records) are reconciled value by
+// value: records with the same content are kept, and updated if their TTL or
+// priority differ; the remaining records are updated with the remaining desired
+// contents, then created or deleted. A zero desired TTL matches any TTL.
+//
+// The SOA record and the apex NS records are managed by Exoscale, so they are
+// ignored on both sides.
+func Diff(current, desired []v3.DNSDomainRecord) *Plan {
+	currentSets := groupRecords(current)
+	desiredSets := groupRecords(desired)
+
+	keys := make([]recordSetKey, 0, len(currentSets)+len(desiredSets))
+	for key := range desiredSets {
+		keys = append(keys, key)
+	}
+	for key := range currentSets {
+		if _, ok := desiredSets[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		return keys[i].rtype < keys[j].rtype
+	})
+
+	plan := &Plan{}
+	for _, key := range keys {
+		diffRecordSet(plan, currentSets[key], desiredSets[key])
+	}
+
+	return plan
+}
+
+func groupRecords(records []v3.DNSDomainRecord) map[recordSetKey][]v3.DNSDomainRecord {
+	sets := make(map[recordSetKey][]v3.DNSDomainRecord)
+	for _, record := range records {
+		key := recordSetKey{
+			name:  strings.ToLower(strings.TrimSuffix(record.Name, ".")),
+			rtype: v3.DNSDomainRecordType(strings.ToUpper(string(record.Type))),
+		}
+		if key.name == "@" {
+			key.name = ""
+		}
+		if isManaged(key) {
+			continue
+		}
+		sets[key] = append(sets[key], record)
+	}
+
+	return sets
+}
+
+// isManaged returns whether a record set is managed by Exoscale.
+func isManaged(key recordSetKey) bool {
+	return key.rtype == v3.DNSDomainRecordTypeSOA || (key.rtype == v3.DNSDomainRecordTypeNS && key.name == "")
+}
+
+func diffRecordSet(plan *Plan, current, desired []v3.DNSDomainRecord) {
+	var (
+		unmatched []v3.DNSDomainRecord
+		matched   = make(map[int]bool)
+	)
+
+desired:
+	for _, d := range desired {
+		for i, c := range current {
+			if matched[i] || normalizeContent(c) != normalizeContent(d) {
+				continue
+			}
+			matched[i] = true
+			if !sameSettings(c, d) {
+				plan.Update = append(plan.Update, Update{Current: c, Desired: d})
+			}
+			continue desired
+		}
+		unmatched = append(unmatched, d)
+	}
+
+	var stale []v3.DNSDomainRecord
+	for i, c := range current {
+		if !matched[i] {
+			stale = append(stale, c)
+		}
+	}
+
+	for len(unmatched) > 0 && len(stale) > 0 {
+		plan.Update = append(plan.Update, Update{Current: stale[0], Desired: unmatched[0]})
+		unmatched, stale = unmatched[1:], stale[1:]
+	}
+	plan.Create = append(plan.Create, unmatched...)
+	plan.Delete = append(plan.Delete, stale...)
+}
+
+func sameSettings(current, desired v3.DNSDomainRecord) bool {
+	if desired.Ttl != 0 && desired.Ttl != current.Ttl {
+		return false
+	}
+
+	switch v3.DNSDomainRecordType(strings.ToUpper(string(desired.Type))) {
+	case v3.DNSDomainRecordTypeMX, v3.DNSDomainRecordTypeSRV:
+		return desired.Priority == current.Priority
+	default:
+		return true
+	}
+}
+
+// normalizeContent returns the content of a record in a form comparable across
+// zone files and API responses.
+func normalizeContent(record v3.DNSDomainRecord) string {
+	content := strings.TrimSpace(record.Content)
+
+	switch v3.DNSDomainRecordType(strings.ToUpper(string(record.Type))) {
+	case v3.DNSDomainRecordTypeTXT, v3.DNSDomainRecordTypeSPF:
+		return unquoteText(content)
+	case v3.DNSDomainRecordTypeCNAME, v3.DNSDomainRecordTypeNS, v3.DNSDomainRecordTypeALIAS,
+		v3.DNSDomainRecordTypeMX, v3.DNSDomainRecordTypePOOL:
+		return strings.ToLower(strings.TrimSuffix(content, "."))
+	case v3.DNSDomainRecordTypeSRV:
+		fields := strings.Fields(content)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = strings.ToLower(strings.TrimSuffix(fields[len(fields)-1], "."))
+		}
+		return strings.Join(fields, " ")
+	case v3.DNSDomainRecordTypeAAAA:
+		return strings.ToLower(content)
+	default:
+		return strings.Join(strings.Fields(content), " ")
+	}
+}
+
+// unquoteText returns the text of a TXT record content, made of either a raw
+// text or of quoted character strings.
+func unquoteText(content string) string {
+	if !strings.HasPrefix(content, `"`) {
+		return content
+	}
+
+	var b strings.Builder
+	for rest := content; rest != ""; rest = strings.TrimSpace(rest) {
+		s, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return content
+		}
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return content
+		}
+		b.WriteString(unquoted)
+		rest = rest[len(s):]
+	}
+
+	return b.String()
+}
+
+// Apply applies the plan to a domain: records are created and updated before the
+// stale ones are deleted, waiting for each operation to succeed.
+func (p *Plan) Apply(ctx context.Context, client v3.API, domainID v3.UUID) error {
+	for _, record := range p.Create {
+		op, err := client.CreateDNSDomainRecord(ctx, domainID, v3.CreateDNSDomainRecordRequest{
+			Name:     record.Name,
+			Type:     v3.CreateDNSDomainRecordRequestType(strings.ToUpper(string(record.Type))),
+			Content:  record.Content,
+			Ttl:      record.Ttl,
+			Priority: record.Priority,
+		})
+		if err != nil {
+			return fmt.Errorf("create %s record %q: %w", record.Type, record.Name, err)
+		}
+		if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			return fmt.Errorf("create %s record %q: %w", record.Type, record.Name, err)
+		}
+	}
+
+	for _, update := range p.Update {
+		req := v3.UpdateDNSDomainRecordRequest{
+			Ttl:      update.Desired.Ttl,
+			Priority: update.Desired.Priority,
+		}
+		if normalizeContent(update.Current) != normalizeContent(update.Desired) {
+			req.Content = update.Desired.Content
+		}
+
+		op, err := client.UpdateDNSDomainRecord(ctx, domainID, update.Current.ID, req)
+		if err != nil {
+			return fmt.Errorf("update %s record %q: %w", update.Current.Type, update.Current.Name, err)
+		}
+		if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			return fmt.Errorf("update %s record %q: %w", update.Current.Type, update.Current.Name, err)
+		}
+	}
+
+	for _, record := range p.Delete {
+		op, err := client.DeleteDNSDomainRecord(ctx, domainID, record.ID)
+		if err != nil {
+			return fmt.Errorf("delete %s record %q: %w", record.Type, record.Name, err)
+		}
+		if _, err := client.Wait(ctx, op, v3.OperationStateSuccess); err != nil {
+			return fmt.Errorf("delete %s record %q: %w", record.Type, record.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+// Package dns provides helpers to manage Exoscale DNS domains from RFC 1035
+// zone files: parse a zone file into records, compute the changes needed to make
+// a domain match it, and apply them.
+//
+//	records, err := dns.Parse(zoneFile, "example.net")
+//	if err != nil {
+//		return err
+//	}
+//	current, err := client.ListDNSDomainRecords(ctx, domainID)
+//	if err != nil {
+//		return err
+//	}
+//	plan := dns.Diff(current.DNSDomainRecords, records)
+//	if err := plan.Apply(ctx, client, domainID); err != nil {
+//		return err
+//	}
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// Parse parses an RFC 1035 zone file of the given domain into records, named
+// relative to the domain: the apex records have an empty name.
+//
+// The $ORIGIN and $TTL directives are supported, as well as omitted owners,
+// TTLs and classes. Names in the records content are made absolute, without
+// the trailing dot. MX and SRV priorities are moved to the record Priority.
+func Parse(r io.Reader, domain string) ([]v3.DNSDomainRecord, error) {
+	p := &parser{
+		domain: strings.ToLower(strings.TrimSuffix(domain, ".")),
+	}
+	p.origin = p.domain
+
+	entries, err := splitEntries(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []v3.DNSDomainRecord
+	for _, e := range entries {
+		record, ok, err := p.parseEntry(e)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", e.line, err)
+		}
+		if ok {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// entry is a logical line of a zone file, which can span several physical lines
+// within parentheses.
+type entry struct {
+	line int
+	// blankOwner is true if the entry starts with a blank, inheriting the previous owner.
+	blankOwner bool
+	tokens     []token
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+// splitEntries splits a zone file into entries, stripping comments.
+func splitEntries(r io.Reader) ([]entry, error) {
+	var (
+		entries []entry
+		current *entry
+		depth   int
+		lineNo  int
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+
+		if depth == 0 {
+			current = &entry{
+				line:       lineNo,
+				blankOwner: len(line) > 0 && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		for i := 0; i < len(line); {
+			c := line[i]
+			switch {
+			case c == ';':
+				i = len(line)
+			case c == ' ' || c == '\t':
+				i++
+			case c == '(':
+				depth++
+				i++
+			case c == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parenthesis", lineNo)
+				}
+				depth--
+				i++
+			case c == '"':
+				var b strings.Builder
+				j := i + 1
+				for ; j < len(line) && line[j] != '"'; j++ {
+					if line[j] == '\\' && j+1 < len(line) {
+						j++
+					}
+					b.WriteByte(line[j])
+				}
+				if j == len(line) {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", lineNo)
+				}
+				current.tokens = append(current.tokens, token{value: b.String(), quoted: true})
+				i = j + 1
+			default:
+				j := i
+				for j < len(line) && !strings.ContainsRune(" \t;()\"", rune(line[j])) {
+					j++
+				}
+				current.tokens = append(current.tokens, token{value: line[i:j]})
+				i = j
+			}
+		}
+
+		if depth == 0 && len(current.tokens) > 0 {
+			entries = append(entries, *current)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parenthesis", current.line)
+	}
+
+	return entries, nil
+}
+
+type parser struct {
+	domain string
+	origin string
+
+	defaultTTL int64
+	lastTTL    int64
+	lastOwner  string
+	hasOwner   bool
+}
+
+// parseEntry parses a directive or a resource record, returning false if the
+// entry is not a record.
+func (p *parser) parseEntry(e entry) (v3.DNSDomainRecord, bool, error) {
+	tokens := e.tokens
+
+	switch strings.ToUpper(tokens[0].value) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return v3.DNSDomainRecord{}, false, fmt.Errorf("invalid $ORIGIN directive")
+		}
+		p.origin = p.absolute(tokens[1].value)
+		return v3.DNSDomainRecord{}, false, nil
+	case "$TTL":
+		if len(tokens) != 2 {
+			return v3.DNSDomainRecord{}, false, fmt.Errorf("invalid $TTL directive")
+		}
+		ttl, err := parseTTL(tokens[1].value)
+		if err != nil {
+			return v3.DNSDomainRecord{}, false, err
+		}
+		p.defaultTTL = ttl
+		return v3.DNSDomainRecord{}, false, nil
+	case "$INCLUDE", "$GENERATE":
+		return v3.DNSDomainRecord{}, false, fmt.Errorf("unsupported %s directive", tokens[0].value)
+	}
+
+	var owner string
+	if e.blankOwner {
+		if !p.hasOwner {
+			return v3.DNSDomainRecord{}, false, fmt.Errorf("missing owner name")
+		}
+		owner = p.lastOwner
+	} else {
+		owner = p.absolute(tokens[0].value)
+		tokens = tokens[1:]
+	}
+	p.lastOwner, p.hasOwner = owner, true
+
+	name, err := p.relative(owner)
+	if err != nil {
+		return v3.DNSDomainRecord{}, false, err
+	}
+
+	// The TTL and the class are optional, and can come in any order.
+	ttl := int64(-1)
+	for len(tokens) > 0 {
+		v := tokens[0].value
+		if strings.EqualFold(v, "IN") {
+			tokens = tokens[1:]
+			continue
+		}
+		if strings.EqualFold(v, "CH") || strings.EqualFold(v, "HS") {
+			return v3.DNSDomainRecord{}, false, fmt.Errorf("unsupported class %s", v)
+		}
+		if v != "" && unicode.IsDigit(rune(v[0])) {
+			if ttl, err = parseTTL(v); err != nil {
+				return v3.DNSDomainRecord{}, false, err
+			}
+			tokens = tokens[1:]
+			continue
+		}
+		break
+	}
+	if len(tokens) < 2 {
+		return v3.DNSDomainRecord{}, false, fmt.Errorf("missing record type or data")
+	}
+
+	switch {
+	case ttl >= 0:
+		p.lastTTL = ttl
+	case p.defaultTTL > 0:
+		ttl = p.defaultTTL
+	default:
+		ttl = p.lastTTL
+	}
+
+	record := v3.DNSDomainRecord{
+		Name: name,
+		Type: v3.DNSDomainRecordType(strings.ToUpper(tokens[0].value)),
+		Ttl:  ttl,
+	}
+	if err := p.parseData(&record, tokens[1:]); err != nil {
+		return v3.DNSDomainRecord{}, false, fmt.Errorf("%s record: %w", record.Type, err)
+	}
+
+	return record, true, nil
+}
+
+// parseData parses the data of a record into its content and priority.
+func (p *parser) parseData(record *v3.DNSDomainRecord, data []token) error {
+	values := make([]string, len(data))
+	for i, t := range data {
+		values[i] = t.value
+	}
+
+	switch record.Type {
+	case v3.DNSDomainRecordTypeCNAME, v3.DNSDomainRecordTypeNS, v3.DNSDomainRecordTypeALIAS, v3.DNSDomainRecordTypePOOL:
+		if len(values) != 1 {
+			return fmt.Errorf("expected a single name")
+		}
+		record.Content = p.absolute(values[0])
+
+	case v3.DNSDomainRecordTypeMX:
+		if len(values) != 2 {
+			return fmt.Errorf("expected a priority and a name")
+		}
+		priority, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid priority %q", values[0])
+		}
+		record.Priority = priority
+		record.Content = p.absolute(values[1])
+
+	case v3.DNSDomainRecordTypeSRV:
+		if len(values) != 4 {
+			return fmt.Errorf("expected a priority, a weight, a port and a target")
+		}
+		priority, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid priority %q", values[0])
+		}
+		record.Priority = priority
+		record.Content = strings.Join([]string{values[1], values[2], p.absolute(values[3])}, " ")
+
+	case v3.DNSDomainRecordTypeTXT, v3.DNSDomainRecordTypeSPF:
+		// Character strings longer than 255 bytes are split in several strings,
+		// which form a single text.
+		record.Content = strings.Join(values, "")
+
+	default:
+		parts := make([]string, len(data))
+		for i, t := range data {
+			parts[i] = t.value
+			if t.quoted {
+				parts[i] = strconv.Quote(t.value)
+			}
+		}
+		record.Content = strings.Join(parts, " ")
+	}
+
+	return nil
+}
+
+// absolute returns the absolute form of a name without the trailing dot.
+func (p *parser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case p.origin == "":
+		return name
+	default:
+		return name + "." + p.origin
+	}
+}
+
+// relative returns an absolute owner name relative to the domain.
+func (p *parser) relative(owner string) (string, error) {
+	owner = strings.ToLower(owner)
+	if owner == p.domain {
+		return "", nil
+	}
+
+	name, ok := strings.CutSuffix(owner, "."+p.domain)
+	if !ok {
+		return "", fmt.Errorf("name %q out of domain %q", owner, p.domain)
+	}
+
+	return name, nil
+}
+
+// parseTTL parses a TTL in seconds, or with units such as "1h30m".
+func parseTTL(v string) (int64, error) {
+	if ttl, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return ttl, nil
+	}
+
+	var ttl, n int64
+	digits := false
+	for _, c := range strings.ToLower(v) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits = true
+			continue
+		}
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %q", v)
+		}
+
+		switch c {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 3600
+		case 'd':
+			n *= 86400
+		case 'w':
+			n *= 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", v)
+		}
+		ttl += n
+		n, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", v)
+	}
+
+	return ttl, nil
+}
@@ -0,0 +1,419 @@
+package v3
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// ChangeAction represents the kind of change applied to a resource by an
+// Ensure helper.
+type ChangeAction string
+
+const (
+	ChangeActionCreate  ChangeAction = "create"
+	ChangeActionUpdate  ChangeAction = "update"
+	ChangeActionReset   ChangeAction = "reset"
+	ChangeActionReplace ChangeAction = "replace"
+)
+
+// Change describes a change applied to a resource by an Ensure helper.
+type Change struct {
+	Action ChangeAction
+	// Field is the name of the updated or reset field, empty for creations and
+	// replacements.
+	Field string
+}
+
+func (c Change) String() string {
+	if c.Field == "" {
+		return string(c.Action)
+	}
+
+	return string(c.Action) + " " + c.Field
+}
+
+// Changes is the summary of the changes applied to a resource by an Ensure
+// helper, empty if the resource already matched the spec.
+type Changes []Change
+
+// Changed returns whether any change was applied.
+func (c Changes) Changed() bool {
+	return len(c) > 0
+}
+
+func (c Changes) String() string {
+	if len(c) == 0 {
+		return "no change"
+	}
+
+	s := make([]string, len(c))
+	for i, change := range c {
+		s[i] = change.String()
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// EnsureSecurityGroup ensures that a Security Group named after the spec exists
+// with the spec description, creating it if needed. Its rules are left untouched.
+// Since a Security Group cannot be updated, an ErrImmutable error is returned if
+// the existing one has another description.
+func (c Client) EnsureSecurityGroup(ctx context.Context, spec CreateSecurityGroupRequest) (*SecurityGroup, Changes, error) {
+	list, err := c.ListSecurityGroups(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureSecurityGroup: %w", err)
+	}
+
+	current, err := list.FindSecurityGroup(spec.Name)
+	if err == nil {
+		if current.Description != spec.Description {
+			return nil, nil, fmt.Errorf("EnsureSecurityGroup: %q description: %w", spec.Name, ErrImmutable)
+		}
+
+		return &current, nil, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, nil, fmt.Errorf("EnsureSecurityGroup: %w", err)
+	}
+
+	op, err := c.CreateSecurityGroup(ctx, spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureSecurityGroup: %w", err)
+	}
+	id, err := c.waitReference(ctx, op)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureSecurityGroup: %w", err)
+	}
+	sg, err := c.GetSecurityGroup(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureSecurityGroup: %w", err)
+	}
+
+	return sg, Changes{{Action: ChangeActionCreate}}, nil
+}
+
+// EnsureAntiAffinityGroup ensures that an Anti-affinity Group named after the
+// spec exists with the spec description, creating it if needed. Since an
+// Anti-affinity Group cannot be updated, an ErrImmutable error is returned if the
+// existing one has another description.
+func (c Client) EnsureAntiAffinityGroup(ctx context.Context, spec CreateAntiAffinityGroupRequest) (*AntiAffinityGroup, Changes, error) {
+	list, err := c.ListAntiAffinityGroups(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureAntiAffinityGroup: %w", err)
+	}
+
+	current, err := list.FindAntiAffinityGroup(spec.Name)
+	if err == nil {
+		if current.Description != spec.Description {
+			return nil, nil, fmt.Errorf("EnsureAntiAffinityGroup: %q description: %w", spec.Name, ErrImmutable)
+		}
+
+		return &current, nil, nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return nil, nil, fmt.Errorf("EnsureAntiAffinityGroup: %w", err)
+	}
+
+	op, err := c.CreateAntiAffinityGroup(ctx, spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureAntiAffinityGroup: %w", err)
+	}
+	id, err := c.waitReference(ctx, op)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureAntiAffinityGroup: %w", err)
+	}
+	aag, err := c.GetAntiAffinityGroup(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureAntiAffinityGroup: %w", err)
+	}
+
+	return aag, Changes{{Action: ChangeActionCreate}}, nil
+}
+
+// EnsurePrivateNetwork ensures that a Private Network named after the spec
+// exists and matches it, creating or updating it if needed. Empty spec fields
+// are left untouched, except Labels: nil Labels are left untouched while empty
+// Labels are reset.
+func (c Client) EnsurePrivateNetwork(ctx context.Context, spec CreatePrivateNetworkRequest) (*PrivateNetwork, Changes, error) {
+	list, err := c.ListPrivateNetworks(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+	}
+
+	current, err := list.FindPrivateNetwork(spec.Name)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+
+		op, err := c.CreatePrivateNetwork(ctx, spec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+		id, err := c.waitReference(ctx, op)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+		pn, err := c.GetPrivateNetwork(ctx, id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+
+		return pn, Changes{{Action: ChangeActionCreate}}, nil
+	}
+
+	var (
+		update  UpdatePrivateNetworkRequest
+		changes Changes
+	)
+	if spec.Description != "" && spec.Description != current.Description {
+		update.Description = spec.Description
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "description"})
+	}
+	if spec.StartIP != nil && !spec.StartIP.Equal(current.StartIP) {
+		update.StartIP = spec.StartIP
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "start-ip"})
+	}
+	if spec.EndIP != nil && !spec.EndIP.Equal(current.EndIP) {
+		update.EndIP = spec.EndIP
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "end-ip"})
+	}
+	if spec.Netmask != nil && !spec.Netmask.Equal(current.Netmask) {
+		update.Netmask = spec.Netmask
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "netmask"})
+	}
+
+	resetLabels := spec.Labels != nil && len(spec.Labels) == 0 && len(current.Labels) > 0
+	if len(spec.Labels) > 0 && !maps.Equal(spec.Labels, current.Labels) {
+		update.Labels = spec.Labels
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "labels"})
+	}
+
+	if len(changes) > 0 {
+		op, err := c.UpdatePrivateNetwork(ctx, current.ID, update)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+	}
+
+	if resetLabels {
+		op, err := c.ResetPrivateNetworkField(ctx, current.ID, ResetPrivateNetworkFieldFieldLabels)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+		}
+		changes = append(changes, Change{Action: ChangeActionReset, Field: "labels"})
+	}
+
+	if len(changes) == 0 {
+		return &current, nil, nil
+	}
+
+	pn, err := c.GetPrivateNetwork(ctx, current.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsurePrivateNetwork: %w", err)
+	}
+
+	return pn, changes, nil
+}
+
+// EnsureSSHKey ensures that an SSH key named after the spec is registered with
+// the spec public key, registering it if needed. Since an SSH key cannot be
+// updated, it is replaced if its fingerprint does not match the public key.
+func (c Client) EnsureSSHKey(ctx context.Context, spec RegisterSSHKeyRequest) (*SSHKey, Changes, error) {
+	var changes Changes
+
+	current, err := c.GetSSHKey(ctx, spec.Name)
+	switch {
+	case err == nil:
+		if sshKeyFingerprintMatches(spec.PublicKey, current.Fingerprint) {
+			return current, nil, nil
+		}
+
+		op, err := c.DeleteSSHKey(ctx, spec.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsureSSHKey: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, nil, fmt.Errorf("EnsureSSHKey: %w", err)
+		}
+		changes = Changes{{Action: ChangeActionReplace}}
+	case errors.Is(err, ErrNotFound):
+		changes = Changes{{Action: ChangeActionCreate}}
+	default:
+		return nil, nil, fmt.Errorf("EnsureSSHKey: %w", err)
+	}
+
+	op, err := c.RegisterSSHKey(ctx, spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureSSHKey: %w", err)
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return nil, nil, fmt.Errorf("EnsureSSHKey: %w", err)
+	}
+	key, err := c.GetSSHKey(ctx, spec.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureSSHKey: %w", err)
+	}
+
+	return key, changes, nil
+}
+
+// EnsureDNSDomainRecordOpt represents a function setting an EnsureDNSDomainRecord option.
+type EnsureDNSDomainRecordOpt func(*ensureDNSDomainRecordOptions)
+
+type ensureDNSDomainRecordOptions struct {
+	replace bool
+}
+
+// EnsureDNSDomainRecordOptWithReplace returns an EnsureDNSDomainRecordOpt
+// updating the content of the single record with the spec name and type, if
+// any, instead of creating a new record when none has the spec content. An
+// ErrTooManyFound error is returned if several records have the spec name and
+// type, but none the spec content.
+func EnsureDNSDomainRecordOptWithReplace() EnsureDNSDomainRecordOpt {
+	return func(o *ensureDNSDomainRecordOptions) {
+		o.replace = true
+	}
+}
+
+// EnsureDNSDomainRecord ensures that a DNS domain record matching the spec
+// exists, creating or updating it if needed. An existing record matches if it
+// has the spec name, type and content, so that records sharing a name and type
+// (e.g. TXT or MX records) are preserved. Zero TTL and priority are left untouched.
+func (c Client) EnsureDNSDomainRecord(ctx context.Context, domainID UUID, spec CreateDNSDomainRecordRequest, opts ...EnsureDNSDomainRecordOpt) (*DNSDomainRecord, Changes, error) {
+	var o ensureDNSDomainRecordOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	list, err := c.ListDNSDomainRecords(ctx, domainID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+	}
+
+	var candidates []DNSDomainRecord
+	for _, record := range list.DNSDomainRecords {
+		if record.Name != spec.Name || string(record.Type) != string(spec.Type) {
+			continue
+		}
+		if record.Content == spec.Content {
+			candidates = []DNSDomainRecord{record}
+			break
+		}
+		if o.replace {
+			candidates = append(candidates, record)
+		}
+	}
+
+	if len(candidates) > 1 {
+		return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w: %d %s records named %q to replace",
+			ErrTooManyFound, len(candidates), spec.Type, spec.Name)
+	}
+
+	if len(candidates) == 0 {
+		op, err := c.CreateDNSDomainRecord(ctx, domainID, spec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+		}
+		id, err := c.waitReference(ctx, op)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+		}
+		record, err := c.GetDNSDomainRecord(ctx, domainID, id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+		}
+
+		return record, Changes{{Action: ChangeActionCreate}}, nil
+	}
+
+	current := candidates[0]
+
+	var (
+		update  UpdateDNSDomainRecordRequest
+		changes Changes
+	)
+	if spec.Content != current.Content {
+		update.Content = spec.Content
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "content"})
+	}
+	if spec.Ttl != 0 && spec.Ttl != current.Ttl {
+		update.Ttl = spec.Ttl
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "ttl"})
+	}
+	if spec.Priority != 0 && spec.Priority != current.Priority {
+		update.Priority = spec.Priority
+		changes = append(changes, Change{Action: ChangeActionUpdate, Field: "priority"})
+	}
+
+	if len(changes) == 0 {
+		return &current, nil, nil
+	}
+
+	op, err := c.UpdateDNSDomainRecord(ctx, domainID, current.ID, update)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+	}
+	record, err := c.GetDNSDomainRecord(ctx, domainID, current.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("EnsureDNSDomainRecord: %w", err)
+	}
+
+	return record, changes, nil
+}
+
+// waitReference waits for an operation to succeed and returns the ID of the
+// resource it references.
+func (c Client) waitReference(ctx context.Context, op *Operation) (UUID, error) {
+	op, err := c.Wait(ctx, op, OperationStateSuccess)
+	if err != nil {
+		return "", err
+	}
+	if op.Reference == nil {
+		return "", fmt.Errorf("operation %q: no resource reference", op.ID)
+	}
+
+	return op.Reference.ID, nil
+}
+
+// sshKeyFingerprintMatches returns whether an SSH key fingerprint, either in
+// the legacy MD5 or in the SHA256 format, is the one of an authorized_keys
+// formatted public key.
+func sshKeyFingerprintMatches(publicKey, fingerprint string) bool {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return false
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return false
+	}
+
+	if fp, ok := strings.CutPrefix(fingerprint, "SHA256:"); ok {
+		sum := sha256.Sum256(blob)
+		return fp == base64.RawStdEncoding.EncodeToString(sum[:])
+	}
+
+	sum := md5.Sum(blob) //nolint:gosec
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.EqualFold(strings.TrimPrefix(fingerprint, "MD5:"), strings.Join(hex, ":"))
+}
@@ -0,0 +1,171 @@
+package v3_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+const (
+	testPublicKey      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHQ2y1nP0nkzvYdD7WOfDbb0xvZ8bl2SU9ptDl3oL+Cv test@example"
+	testOtherPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIO8MrDvW3HtU6X8aWPiC7zxX6ZeS9WZBf9nYoT2yX8Ak test@example"
+)
+
+func TestEnsure(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	t.Run("SecurityGroup", func(t *testing.T) {
+		spec := v3.CreateSecurityGroupRequest{Name: "web", Description: "Web servers"}
+
+		sg, changes, err := client.EnsureSecurityGroup(ctx, spec)
+		require.NoError(t, err)
+		require.Equal(t, v3.Changes{{Action: v3.ChangeActionCreate}}, changes)
+		require.Equal(t, "web", sg.Name)
+
+		again, changes, err := client.EnsureSecurityGroup(ctx, spec)
+		require.NoError(t, err)
+		require.False(t, changes.Changed())
+		require.Equal(t, sg.ID, again.ID)
+
+		spec.Description = "Other"
+		_, _, err = client.EnsureSecurityGroup(ctx, spec)
+		require.ErrorIs(t, err, v3.ErrImmutable)
+	})
+
+	t.Run("PrivateNetwork", func(t *testing.T) {
+		spec := v3.CreatePrivateNetworkRequest{
+			Name:    "backend",
+			StartIP: net.ParseIP("10.0.0.10"),
+			EndIP:   net.ParseIP("10.0.0.100"),
+			Netmask: net.ParseIP("255.255.255.0"),
+			Labels:  v3.Labels{"env": "test"},
+		}
+
+		pn, changes, err := client.EnsurePrivateNetwork(ctx, spec)
+		require.NoError(t, err)
+		require.Equal(t, v3.Changes{{Action: v3.ChangeActionCreate}}, changes)
+
+		_, changes, err = client.EnsurePrivateNetwork(ctx, spec)
+		require.NoError(t, err)
+		require.False(t, changes.Changed())
+
+		spec.EndIP = net.ParseIP("10.0.0.200")
+		spec.Description = "Backend"
+		pn, changes, err = client.EnsurePrivateNetwork(ctx, spec)
+		require.NoError(t, err)
+		require.Equal(t, "update description, update end-ip", changes.String())
+		require.Equal(t, "Backend", pn.Description)
+		require.True(t, pn.EndIP.Equal(spec.EndIP))
+
+		spec.Labels = v3.Labels{}
+		pn, changes, err = client.EnsurePrivateNetwork(ctx, spec)
+		require.NoError(t, err)
+		require.Equal(t, v3.Changes{{Action: v3.ChangeActionReset, Field: "labels"}}, changes)
+		require.Empty(t, pn.Labels)
+	})
+
+	t.Run("SSHKey", func(t *testing.T) {
+		spec := v3.RegisterSSHKeyRequest{Name: "deploy", PublicKey: testPublicKey}
+
+		_, changes, err := client.EnsureSSHKey(ctx, spec)
+		require.NoError(t, err)
+		require.Equal(t, v3.Changes{{Action: v3.ChangeActionCreate}}, changes)
+
+		_, changes, err = client.EnsureSSHKey(ctx, spec)
+		require.NoError(t, err)
+		require.False(t, changes.Changed())
+
+		spec.PublicKey = testOtherPublicKey
+		_, changes, err = client.EnsureSSHKey(ctx, spec)
+		require.NoError(t, err)
+		require.Equal(t, v3.Changes{{Action: v3.ChangeActionReplace}}, changes)
+	})
+
+	t.Run("DNSDomainRecord", func(t *testing.T) {
+		domain, err := client.CreateDNSDomain(ctx, v3.CreateDNSDomainRequest{UnicodeName: "example.net"})
+		require.NoError(t, err)
+
+		spec := v3.CreateDNSDomainRecordRequest{
+			Name:    "www",
+			Type:    v3.CreateDNSDomainRecordRequestTypeA,
+			Content: "192.0.2.1",
+			Ttl:     3600,
+		}
+
+		record, changes, err := client.EnsureDNSDomainRecord(ctx, domain.ID, spec)
+		require.NoError(t, err)
+		require.Equal(t, v3.Changes{{Action: v3.ChangeActionCreate}}, changes)
+
+		spec.Content = "192.0.2.2"
+		spec.Ttl = 300
+		updated, changes, err := client.EnsureDNSDomainRecord(ctx, domain.ID, spec, v3.EnsureDNSDomainRecordOptWithReplace())
+		require.NoError(t, err)
+		require.Equal(t, "update content, update ttl", changes.String())
+		require.Equal(t, record.ID, updated.ID)
+		require.Equal(t, "192.0.2.2", updated.Content)
+
+		_, changes, err = client.EnsureDNSDomainRecord(ctx, domain.ID, spec)
+		require.NoError(t, err)
+		require.False(t, changes.Changed())
+
+		t.Run("Sibling", func(t *testing.T) {
+			spf := v3.CreateDNSDomainRecordRequest{
+				Name:    "",
+				Type:    v3.CreateDNSDomainRecordRequestTypeTXT,
+				Content: "v=spf1 -all",
+			}
+			sibling, _, err := client.EnsureDNSDomainRecord(ctx, domain.ID, spf)
+			require.NoError(t, err)
+
+			verification := v3.CreateDNSDomainRecordRequest{
+				Name:    "",
+				Type:    v3.CreateDNSDomainRecordRequestTypeTXT,
+				Content: "site-verification=abc",
+			}
+			record, changes, err := client.EnsureDNSDomainRecord(ctx, domain.ID, verification)
+			require.NoError(t, err)
+			require.Equal(t, v3.Changes{{Action: v3.ChangeActionCreate}}, changes)
+			require.NotEqual(t, sibling.ID, record.ID)
+
+			// The record with the same name and type but another content survives.
+			current, err := client.GetDNSDomainRecord(ctx, domain.ID, sibling.ID)
+			require.NoError(t, err)
+			require.Equal(t, "v=spf1 -all", current.Content)
+
+			_, changes, err = client.EnsureDNSDomainRecord(ctx, domain.ID, spf)
+			require.NoError(t, err)
+			require.False(t, changes.Changed())
+		})
+
+		t.Run("ReplaceAmbiguous", func(t *testing.T) {
+			spec := v3.CreateDNSDomainRecordRequest{
+				Name:    "",
+				Type:    v3.CreateDNSDomainRecordRequestTypeTXT,
+				Content: "v=spf1 include:example.org -all",
+			}
+			_, _, err := client.EnsureDNSDomainRecord(ctx, domain.ID, spec, v3.EnsureDNSDomainRecordOptWithReplace())
+			require.ErrorIs(t, err, v3.ErrTooManyFound)
+
+			records, err := client.ListDNSDomainRecords(ctx, domain.ID)
+			require.NoError(t, err)
+			var txt []string
+			for _, record := range records.DNSDomainRecords {
+				if record.Type == v3.DNSDomainRecordTypeTXT {
+					txt = append(txt, record.Content)
+				}
+			}
+			require.ElementsMatch(t, []string{"v=spf1 -all", "site-verification=abc"}, txt)
+		})
+	})
+}
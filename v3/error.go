@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// APIError represents an error response returned by the Exoscale API.
+//
+// APIError wraps one of ErrNotFound, ErrInvalidRequest or ErrAPIError depending
+// on the response status code, so it can be matched with errors.Is while its
+// details remain available using errors.As.
+type APIError struct {
+	// StatusCode is the HTTP response status code.
+	StatusCode int
+	// Method is the HTTP method of the failed request.
+	Method string
+	// OperationID is the ID of the API operation, e.g. "list-instances".
+	OperationID string
+	// Message is the error message returned by the API.
+	Message string
+	// Details holds the remaining fields of the API error response body,
+	// such as error codes or invalid request fields.
+	Details map[string]any
+	// Header holds the HTTP response headers.
+	Header http.Header
+	// Body is the raw HTTP response body.
+	Body []byte
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return e.Unwrap().Error()
+	}
+
+	return fmt.Sprintf("%v: %s", e.Unwrap(), e.Message)
+}
+
+// Unwrap returns the sentinel error matching the API error status code.
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode >= 400 && e.StatusCode < 500:
+		return ErrInvalidRequest
+	default:
+		return ErrAPIError
+	}
+}
+
+// RequestID returns the ID assigned by the API to the failed request, if any.
+func (e *APIError) RequestID() string {
+	return e.Header.Get("X-Request-Id")
+}
@@ -0,0 +1,36 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "c0ffee")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"name already in use","field":"name"}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	_, err := client.CreateAntiAffinityGroup(context.Background(), CreateAntiAffinityGroupRequest{Name: "test"})
+	require.ErrorIs(t, err, ErrInvalidRequest)
+	require.NotErrorIs(t, err, ErrNotFound)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	require.Equal(t, http.MethodPost, apiErr.Method)
+	require.Equal(t, "create-anti-affinity-group", apiErr.OperationID)
+	require.Equal(t, "name already in use", apiErr.Message)
+	require.Equal(t, map[string]any{"field": "name"}, apiErr.Details)
+	require.Equal(t, "c0ffee", apiErr.RequestID())
+	require.Equal(t, "CreateAntiAffinityGroup: http response: invalid request: name already in use", err.Error())
+}
@@ -0,0 +1,378 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/sauterp/egoscale/v3/generator/helpers"
+)
+
+// ModulePath is the import path of the generated package, used by the mock.
+const ModulePath = "github.com/sauterp/egoscale/v3"
+
+const (
+	interfaceFile = "interface.go"
+	mockDir       = "mock"
+	mockFile      = "api.go"
+)
+
+const interfaceTemplate = `
+// API is the interface implemented by Client, covering the API operations
+// and the helpers built on top of them.
+// It allows substituting the Client in tests, e.g. with the mock package.
+type API interface {
+{{- range .Methods }}
+{{- range .Doc }}
+	// {{ . }}
+{{- end }}
+	{{ .Name }}({{ .Params }}) {{ .Results }}
+{{- end }}
+}
+
+var _ API = Client{}
+`
+
+const mockTemplate = `
+// API is a mock implementation of {{ .Package }}.API.
+type API struct {
+	testifymock.Mock
+}
+
+var _ {{ .Package }}.API = (*API)(nil)
+
+// NewAPI returns an API mock asserting its expectations when the test ends.
+func NewAPI(t interface {
+	testifymock.TestingT
+	Cleanup(func())
+}) *API {
+	m := &API{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+
+	return m
+}
+{{ range .Methods }}
+// {{ .Name }} mocks {{ $.Package }}.Client.{{ .Name }}.
+func (m *API) {{ .Name }}({{ .MockParams }}) {{ .MockResults }} {
+	{{ if .Returns }}args := {{ end }}m.Called({{ .Args }})
+{{- range .Returns }}
+	{{- if not .IsError }}
+	r{{ .Index }}, _ := args.Get({{ .Index }}).({{ .Type }})
+	{{- end }}
+{{- end }}
+{{- if .Returns }}
+
+	return {{ range $i, $r := .Returns }}{{ if $i }}, {{ end }}{{ if .IsError }}args.Error({{ .Index }}){{ else }}r{{ .Index }}{{ end }}{{ end }}
+{{- end }}
+}
+{{ end }}`
+
+// Method is used by the interface and mock templates.
+type Method struct {
+	Name        string
+	Doc         []string
+	Params      string
+	Results     string
+	MockParams  string
+	MockResults string
+	Args        string
+	Returns     []Return
+}
+
+// Return is used by the mock template.
+type Return struct {
+	Index   int
+	Type    string
+	IsError bool
+}
+
+// Generate parses the Client methods of the package in dir, then generates the
+// API interface they implement and its mock implementation in a subpackage.
+// It must run once the other files of the package are generated.
+func Generate(dir, packageName string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != interfaceFile
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	pkg, ok := pkgs[packageName]
+	if !ok {
+		return fmt.Errorf("package %s not found in %s", packageName, dir)
+	}
+
+	methods, imports, err := parseMethods(fset, pkg, packageName)
+	if err != nil {
+		return err
+	}
+
+	output := bytes.NewBuffer(helpers.Header(packageName, "v0.0.1"))
+	output.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	writeImports(output, imports)
+	if err := executeTemplate(output, interfaceTemplate, map[string]any{"Methods": methods}); err != nil {
+		return err
+	}
+	if err := writeSource(filepath.Join(dir, interfaceFile), output.Bytes()); err != nil {
+		return fmt.Errorf("interface: %w", err)
+	}
+
+	output = bytes.NewBufferString(`// Package mock provides a mock implementation of the API interface.
+//
+// Code generated by github.com/egoscale/v3/generator version v0.0.1 DO NOT EDIT.
+package mock
+
+`)
+	imports[packageName] = ModulePath
+	imports["testifymock"] = "github.com/stretchr/testify/mock"
+	writeImports(output, imports)
+	if err := executeTemplate(output, mockTemplate, map[string]any{
+		"Package": packageName,
+		"Methods": methods,
+	}); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, mockDir), os.ModePerm); err != nil {
+		return err
+	}
+	if err := writeSource(filepath.Join(dir, mockDir, mockFile), output.Bytes()); err != nil {
+		return fmt.Errorf("mock: %w", err)
+	}
+
+	return nil
+}
+
+// parseMethods returns the exported Client methods, except the ones returning
+// a Client copy, along with the imports their signatures require.
+func parseMethods(fset *token.FileSet, pkg *ast.Package, packageName string) ([]Method, map[string]string, error) {
+	fileNames := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	methods := []Method{}
+	imports := map[string]string{}
+	for _, name := range fileNames {
+		file := pkg.Files[name]
+
+		fileImports := map[string]string{}
+		for _, imp := range file.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			alias := filepath.Base(path)
+			if imp.Name != nil {
+				alias = imp.Name.Name
+			}
+			fileImports[alias] = path
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() || !isClientReceiver(fn.Recv) {
+				continue
+			}
+			if fn.Type.Results != nil && hasClientField(fn.Type.Results) {
+				continue
+			}
+
+			m, err := newMethod(fset, fn, packageName)
+			if err != nil {
+				return nil, nil, err
+			}
+			methods = append(methods, m)
+
+			ast.Inspect(fn.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if id, ok := sel.X.(*ast.Ident); ok {
+						imports[id.Name] = fileImports[id.Name]
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+
+	return methods, imports, nil
+}
+
+func newMethod(fset *token.FileSet, fn *ast.FuncDecl, packageName string) (Method, error) {
+	m := Method{Name: fn.Name.Name}
+
+	if fn.Doc != nil {
+		for _, line := range strings.Split(strings.TrimSpace(fn.Doc.Text()), "\n") {
+			m.Doc = append(m.Doc, line)
+		}
+	}
+
+	var params, mockParams, args []string
+	for i, field := range fn.Type.Params.List {
+		typ, err := renderNode(fset, field.Type)
+		if err != nil {
+			return m, err
+		}
+
+		names := []string{}
+		for _, n := range field.Names {
+			names = append(names, n.Name)
+		}
+		if len(names) == 0 {
+			names = append(names, fmt.Sprintf("p%d", i))
+		}
+
+		for _, n := range names {
+			params = append(params, n+" "+typ)
+			mockParams = append(mockParams, n+" "+qualify(typ, packageName))
+			args = append(args, n)
+		}
+	}
+	m.Params = strings.Join(params, ", ")
+	m.MockParams = strings.Join(mockParams, ", ")
+	m.Args = strings.Join(args, ", ")
+
+	var results, mockResults []string
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			typ, err := renderNode(fset, field.Type)
+			if err != nil {
+				return m, err
+			}
+
+			count := max(len(field.Names), 1)
+			for range count {
+				results = append(results, typ)
+				mockResults = append(mockResults, qualify(typ, packageName))
+				m.Returns = append(m.Returns, Return{
+					Index:   len(m.Returns),
+					Type:    qualify(typ, packageName),
+					IsError: typ == "error",
+				})
+			}
+		}
+	}
+	m.Results = renderResults(results)
+	m.MockResults = renderResults(mockResults)
+
+	return m, nil
+}
+
+func renderResults(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return results[0]
+	}
+
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+func isClientReceiver(recv *ast.FieldList) bool {
+	if recv == nil || len(recv.List) != 1 {
+		return false
+	}
+
+	return isClientType(recv.List[0].Type)
+}
+
+func hasClientField(fields *ast.FieldList) bool {
+	for _, f := range fields.List {
+		if isClientType(f.Type) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isClientType(expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	id, ok := expr.(*ast.Ident)
+
+	return ok && id.Name == "Client"
+}
+
+func renderNode(fset *token.FileSet, node ast.Node) (string, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, node); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// exportedIdentRe matches the exported identifiers not already qualified by a package.
+var exportedIdentRe = regexp.MustCompile(`(^|\.\.\.|[^.\w])([A-Z]\w*)`)
+
+// qualify prefixes the types declared in the generated package with its name,
+// for use in the mock package.
+func qualify(typ, packageName string) string {
+	return exportedIdentRe.ReplaceAllString(typ, "${1}"+packageName+".${2}")
+}
+
+func writeImports(output *bytes.Buffer, imports map[string]string) {
+	aliases := make([]string, 0, len(imports))
+	for alias := range imports {
+		aliases = append(aliases, alias)
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		if isStdLib(imports[aliases[i]]) != isStdLib(imports[aliases[j]]) {
+			return isStdLib(imports[aliases[i]])
+		}
+		return imports[aliases[i]] < imports[aliases[j]]
+	})
+
+	output.WriteString("import (\n")
+	for i, alias := range aliases {
+		if i > 0 && isStdLib(imports[aliases[i-1]]) && !isStdLib(imports[alias]) {
+			output.WriteString("\n")
+		}
+		if filepath.Base(imports[alias]) == alias {
+			output.WriteString(fmt.Sprintf("\t%q\n", imports[alias]))
+			continue
+		}
+		output.WriteString(fmt.Sprintf("\t%s %q\n", alias, imports[alias]))
+	}
+	output.WriteString(")\n")
+}
+
+func isStdLib(path string) bool {
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+
+func executeTemplate(output *bytes.Buffer, text string, data any) error {
+	t, err := template.New("").Parse(text)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(output, data)
+}
+
+func writeSource(path string, src []byte) error {
+	content, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, os.ModePerm)
+}
@@ -30,6 +30,7 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 	output.WriteString(fmt.Sprintf(`package %s
 	import (
 		"fmt"
+		"log/slog"
 		"net/http"
 		"context"
 		"runtime"
@@ -0,0 +1,161 @@
+package iam
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// serviceClasses maps the families of the first segments of the API paths to
+// the IAM policy service classes, for the operations not tagged with a service
+// class. A family matches the segment equal to it or prefixed with it and a
+// dash, the longest family matching. The generator fails on paths matching
+// none of them, so that new ones are classified explicitly.
+var serviceClasses = map[string]string{
+	"anti-affinity-group": "compute",
+	"api-key":             "iam",
+	"block-storage":       "compute",
+	"console":             "compute",
+	"dbaas":               "dbaas",
+	"deploy-target":       "compute",
+	"dns":                 "dns",
+	"elastic-ip":          "compute",
+	"event":               "compute",
+	"iam":                 "iam",
+	"instance":            "compute",
+	"load-balancer":       "compute",
+	"operation":           "compute",
+	"organization":        "compute",
+	"private-network":     "compute",
+	"quota":               "compute",
+	"reverse-dns":         "compute",
+	"security-group":      "compute",
+	"sks-cluster":         "compute",
+	"snapshot":            "compute",
+	"sos":                 "sos",
+	"ssh-key":             "compute",
+	"template":            "compute",
+	"zone":                "compute",
+}
+
+const catalogTemplate = `// Code generated by github.com/egoscale/v3/generator version {{ .Version }} DO NOT EDIT.
+
+package {{ .Package }}
+
+// catalog lists the API operations, sorted by ID.
+var catalog = []Operation{
+{{- range .Operations }}
+	{ID: {{ printf "%q" .ID }}, Service: {{ printf "%q" .Service }}, Method: {{ printf "%q" .Method }}, Path: {{ printf "%q" .Path }}},
+{{- end }}
+}
+`
+
+type operation struct {
+	ID      string
+	Service string
+	Method  string
+	Path    string
+}
+
+// Generate renders the catalog of the OpenAPI spec operations and their IAM
+// policy service class into a go file.
+func Generate(doc libopenapi.Document, path, packageName string) error {
+	model, errs := doc.BuildV3Model()
+	for _, err := range errs {
+		if err != nil {
+			return fmt.Errorf("errors %v", errs)
+		}
+	}
+
+	if orderedmap.Len(model.Model.Paths.PathItems) == 0 {
+		slog.Warn("no path items defined in the spec")
+		return nil
+	}
+
+	var operations []operation
+	for pair := model.Model.Paths.PathItems.First(); pair != nil; pair = pair.Next() {
+		p, pathItem := pair.Key(), pair.Value()
+		for pair := pathItem.GetOperations().First(); pair != nil; pair = pair.Next() {
+			method, op := pair.Key(), pair.Value()
+			if op.OperationId == "" {
+				continue
+			}
+
+			service, err := serviceClass(p, op.Tags)
+			if err != nil {
+				return fmt.Errorf("operation %s: %w", op.OperationId, err)
+			}
+
+			operations = append(operations, operation{
+				ID:      op.OperationId,
+				Service: service,
+				Method:  strings.ToUpper(method),
+				Path:    p,
+			})
+		}
+	}
+	sort.Slice(operations, func(i, j int) bool { return operations[i].ID < operations[j].ID })
+
+	t, err := template.New("catalog").Parse(catalogTemplate)
+	if err != nil {
+		return err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, struct {
+		Version    string
+		Package    string
+		Operations []operation
+	}{
+		Version:    "v0.0.1",
+		Package:    packageName,
+		Operations: operations,
+	}); err != nil {
+		return err
+	}
+
+	if os.Getenv("GENERATOR_DEBUG") == "iam" {
+		fmt.Println(output.String())
+	}
+
+	content, err := format.Source(output.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, os.ModePerm)
+}
+
+// serviceClass returns the IAM policy service class of an API operation: the
+// first of its tags naming a service class, or the class of its path family.
+func serviceClass(path string, tags []string) (string, error) {
+	for _, tag := range tags {
+		for _, class := range serviceClasses {
+			if strings.EqualFold(tag, class) {
+				return class, nil
+			}
+		}
+	}
+
+	segment, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+
+	class, longest := "", 0
+	for family, c := range serviceClasses {
+		if (segment == family || strings.HasPrefix(segment, family+"-")) && len(family) > longest {
+			class, longest = c, len(family)
+		}
+	}
+	if class == "" {
+		return "", fmt.Errorf("no IAM service class for path %s: add its family to serviceClasses", path)
+	}
+
+	return class, nil
+}
@@ -0,0 +1,35 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceClass(t *testing.T) {
+	for path, want := range map[string]string{
+		"/instance-pool/{id}":          "compute",
+		"/dbaas-service-logs/{name}":   "dbaas",
+		"/dns-domain/{id}/record":      "dns",
+		"/api-key":                     "iam",
+		"/iam-role/{id}":               "iam",
+		"/sos/{bucket}/presigned-url":  "sos",
+		"/reverse-dns/elastic-ip/{id}": "compute",
+		"/sks-cluster-kubeconfig/{id}": "compute",
+	} {
+		class, err := serviceClass(path, nil)
+		require.NoError(t, err, path)
+		require.Equal(t, want, class, path)
+	}
+
+	class, err := serviceClass("/block-storage", []string{"Compute"})
+	require.NoError(t, err)
+	require.Equal(t, "compute", class)
+
+	// New path families must be classified explicitly.
+	_, err = serviceClass("/ai-model/{id}", nil)
+	require.ErrorContains(t, err, "/ai-model/{id}")
+	class, err = serviceClass("/ai-model/{id}", []string{"dbaas"})
+	require.NoError(t, err)
+	require.Equal(t, "dbaas", class)
+}
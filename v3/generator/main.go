@@ -8,8 +8,10 @@ import (
 
 	"github.com/pb33f/libopenapi"
 
+	"github.com/sauterp/egoscale/v3/generator/api"
 	"github.com/sauterp/egoscale/v3/generator/client"
 	"github.com/sauterp/egoscale/v3/generator/helpers"
+	"github.com/sauterp/egoscale/v3/generator/iam"
 	"github.com/sauterp/egoscale/v3/generator/operations"
 	"github.com/sauterp/egoscale/v3/generator/schemas"
 )
@@ -48,6 +50,12 @@ func main() {
 	if err := operations.Generate(doc, filepath.Join(genPathDir, "/operations.go"), packageName); err != nil {
 		log.Fatal("operations: ", err)
 	}
+	if err := api.Generate(genPathDir, packageName); err != nil {
+		log.Fatal("api: ", err)
+	}
+	if err := iam.Generate(doc, filepath.Join(genPathDir, "/iam/catalog.go"), "iam"); err != nil {
+		log.Fatal("iam: ", err)
+	}
 
 	if err := os.MkdirAll(genPathDir, os.ModePerm); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,317 @@
+package operations
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sauterp/egoscale/v3/generator/helpers"
+	"github.com/sauterp/egoscale/v3/generator/schemas"
+)
+
+const listIteratorTemplate = `
+// {{ .Name }} returns an iterator over the {{ .ItemType }} returned by {{ .FuncName }}.
+func (c Client) {{ .Name }}({{ .Params }}) iter.Seq2[{{ .ItemType }}, error] {
+	return func(yield func({{ .ItemType }}, error) bool) {
+		resp, err := c.{{ .FuncName }}({{ .Args }})
+		if err != nil {
+			yield({{ .ItemType }}{}, err)
+			return
+		}
+
+		for _, elem := range resp{{ .ItemsField }} {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+`
+
+const timeWindowIteratorTemplate = `
+// {{ .Name }} returns an iterator over the {{ .ItemType }} returned by {{ .FuncName }}
+// between from and to, requesting successive time windows.
+func (c Client) {{ .Name }}({{ .Params }}) iter.Seq2[{{ .ItemType }}, error] {
+	return func(yield func({{ .ItemType }}, error) bool) {
+		for start := from; start.Before(to); start = start.Add(paginationTimeWindow) {
+			end := start.Add(paginationTimeWindow)
+			if end.After(to) {
+				end = to
+			}
+
+			resp, err := c.{{ .FuncName }}({{ .Args }})
+			if err != nil {
+				yield({{ .ItemType }}{}, err)
+				return
+			}
+
+			for _, elem := range resp{{ .ItemsField }} {
+				if !yield(elem, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+`
+
+const offsetIteratorTemplate = `
+// {{ .Name }} returns an iterator over the {{ .ItemType }} returned by {{ .FuncName }},
+// following the offsets returned by the API until no more entries are returned.
+func (c Client) {{ .Name }}({{ .Params }}) iter.Seq2[{{ .ItemType }}, error] {
+	return func(yield func({{ .ItemType }}, error) bool) {
+		for {
+			resp, err := c.{{ .FuncName }}({{ .Args }})
+			if err != nil {
+				yield({{ .ItemType }}{}, err)
+				return
+			}
+
+			for _, elem := range resp{{ .ItemsField }} {
+				if !yield(elem, nil) {
+					return
+				}
+			}
+
+			if len(resp{{ .ItemsField }}) == 0 || resp.Offset == "" || resp.Offset == req.Offset {
+				return
+			}
+			req.Offset = resp.Offset
+		}
+	}
+}
+`
+
+// Iterator is used by the iterator templates.
+type Iterator struct {
+	Name       string
+	FuncName   string
+	Params     string
+	Args       string
+	ItemType   string
+	ItemsField string
+}
+
+// renderIterator renders an iter.Seq2 iterator over the items returned by an operation.
+// Operations accepting a from/to time range are iterated over successive time windows,
+// operations accepting an opaque offset are iterated until the API returns no more items
+// and other list operations are iterated over their single page.
+// Returns nil on operations not returning a list of items.
+func renderIterator(funcName string, op *v3.Operation) ([]byte, error) {
+	itemType, itemsField, respSchema, err := getIterableResponse(funcName, op)
+	if err != nil {
+		return nil, err
+	}
+	if itemType == "" {
+		return nil, nil
+	}
+
+	params := getParameters(op, funcName)
+	it := Iterator{
+		FuncName:   funcName,
+		ItemType:   itemType,
+		ItemsField: itemsField,
+	}
+
+	var tmpl string
+	switch {
+	case hasTimeRangeQueryParams(op):
+		tmpl = timeWindowIteratorTemplate
+		it.Name = "All" + trimVerb(funcName)
+
+		// Other query params are not supported, the time range being set by the iterator.
+		var sig, args []string
+		for _, p := range params {
+			if strings.HasPrefix(p, "opts ") {
+				continue
+			}
+			sig = append(sig, p)
+			args = append(args, strings.Fields(p)[0])
+		}
+		it.Params = strings.Join(append(sig, "from, to time.Time"), ", ")
+		it.Args = strings.Join(append(args, funcName+"WithFrom(start)", funcName+"WithTo(end)"), ", ")
+
+	case hasOffsetRequestBody(op) && respSchema != nil && hasProperty(respSchema, "offset"):
+		tmpl = offsetIteratorTemplate
+		it.Name = "All" + trimVerb(funcName)
+		it.Params = strings.Join(params, ", ")
+		it.Args = renderArgs(params)
+
+	case strings.HasPrefix(funcName, "List"):
+		tmpl = listIteratorTemplate
+		it.Name = "All" + strings.TrimPrefix(funcName, "List")
+		it.Params = strings.Join(params, ", ")
+		it.Args = renderArgs(params)
+
+	default:
+		return nil, nil
+	}
+
+	t, err := template.New("iterator").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, it); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// getIterableResponse returns the item type and the field holding the items
+// of an operation HTTP 200 response, along with the response schema.
+// Returns an empty item type if the response doesn't hold exactly one list of items.
+func getIterableResponse(funcName string, op *v3.Operation) (string, string, *base.Schema, error) {
+	if op.Responses == nil || op.Responses.Codes == nil {
+		return "", "", nil, nil
+	}
+
+	response, ok := op.Responses.Codes.Get("200")
+	if !ok {
+		return "", "", nil, nil
+	}
+
+	media, ok := response.Content.Get("application/json")
+	if !ok {
+		return "", "", nil, nil
+	}
+
+	if a, ok := isArrayReference(media.Schema); ok {
+		return strings.TrimPrefix(a, "[]"), "", nil, nil
+	}
+
+	sc, err := media.Schema.BuildSchema()
+	if err != nil {
+		return "", "", nil, err
+	}
+	schemas.InferType(sc)
+
+	if len(sc.Type) == 0 || sc.Type[0] != "object" || sc.Properties == nil {
+		return "", "", nil, nil
+	}
+
+	typeName := funcName + "Response"
+	if media.Schema.IsReference() {
+		typeName = helpers.RenderReference(media.Schema.GetReference())
+	}
+
+	var itemType, itemsField string
+	for pair := sc.Properties.First(); pair != nil; pair = pair.Next() {
+		propName, propSc := pair.Key(), pair.Value()
+		prop, err := propSc.BuildSchema()
+		if err != nil {
+			return "", "", nil, err
+		}
+		schemas.InferType(prop)
+
+		if len(prop.Type) == 0 || prop.Type[0] != "array" || prop.Items == nil || !prop.Items.IsA() {
+			continue
+		}
+
+		// Only iterate over responses holding a single list of items.
+		if itemType != "" {
+			return "", "", nil, nil
+		}
+
+		itemType = typeName + helpers.ToCamel(propName)
+		if prop.Items.A.IsReference() {
+			itemType = helpers.RenderReference(prop.Items.A.GetReference())
+		} else {
+			item, err := prop.Items.A.BuildSchema()
+			if err != nil {
+				return "", "", nil, err
+			}
+			schemas.InferType(item)
+
+			// Skip lists of scalar values, there is nothing to paginate over.
+			if schemas.IsSimpleSchema(item) {
+				return "", "", nil, nil
+			}
+		}
+		itemsField = "." + helpers.ToCamel(propName)
+	}
+
+	return itemType, itemsField, sc, nil
+}
+
+// hasTimeRangeQueryParams returns true if an operation accepts "from" and "to" date-time query params.
+func hasTimeRangeQueryParams(op *v3.Operation) bool {
+	var from, to bool
+	for _, p := range op.Parameters {
+		if p.In != "query" || p.Schema == nil {
+			continue
+		}
+		s := p.Schema.Schema()
+		if s == nil || s.Format != "date-time" {
+			continue
+		}
+
+		switch p.Name {
+		case "from":
+			from = true
+		case "to":
+			to = true
+		}
+	}
+
+	return from && to
+}
+
+// hasOffsetRequestBody returns true if an operation request body holds an "offset" property.
+func hasOffsetRequestBody(op *v3.Operation) bool {
+	if op.RequestBody == nil {
+		return false
+	}
+
+	media, ok := op.RequestBody.Content.Get("application/json")
+	if !ok {
+		return false
+	}
+
+	sc, err := media.Schema.BuildSchema()
+	if err != nil {
+		return false
+	}
+
+	return hasProperty(sc, "offset")
+}
+
+func hasProperty(sc *base.Schema, name string) bool {
+	if sc.Properties == nil {
+		return false
+	}
+	_, ok := sc.Properties.Get(name)
+
+	return ok
+}
+
+// trimVerb removes the leading List or Get verb from an operation function name.
+func trimVerb(funcName string) string {
+	for _, verb := range []string{"List", "Get"} {
+		if strings.HasPrefix(funcName, verb) {
+			return strings.TrimPrefix(funcName, verb)
+		}
+	}
+
+	return funcName
+}
+
+// renderArgs renders the call arguments matching function parameters.
+func renderArgs(params []string) string {
+	args := make([]string, 0, len(params))
+	for _, p := range params {
+		fields := strings.Fields(p)
+		if len(fields) > 1 && strings.HasPrefix(fields[1], "...") {
+			args = append(args, fields[0]+"...")
+			continue
+		}
+		args = append(args, fields[0])
+	}
+
+	return strings.Join(args, ", ")
+}
@@ -32,6 +32,7 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/http"
 	"net/url"
@@ -88,6 +89,18 @@ import (
 				return err
 			}
 			output.Write(m)
+
+			iterator, err := renderIterator(funcName, operation)
+			if err != nil {
+				return err
+			}
+			output.Write(iterator)
+
+			waitState, err := renderWaitState(funcName, opName, operation)
+			if err != nil {
+				return err
+			}
+			output.Write(waitState)
 		}
 	}
 
@@ -186,7 +199,7 @@ func renderRequestSchema(name string, op *v3.Operation) ([]byte, error) {
 const queryParamTemplate = `
 func {{ .FuncName }}({{ .ParamName }} {{ .ParamType }}) {{ .FuncReturn }} {
 	return func(q url.Values) {
-		q.Add("{{ .ParamName }}", fmt.Sprint({{ .ParamName }}))
+		{{ if eq .ParamType "time.Time" }}q.Add("{{ .ParamName }}", {{ .ParamName }}.Format(time.RFC3339)){{ else }}q.Add("{{ .ParamName }}", fmt.Sprint({{ .ParamName }})){{ end }}
 	}
 }
 `
@@ -374,6 +387,7 @@ type RequestTmpl struct {
 	HTTPMethod     string
 	BodyRequest    bool
 	BodyRespType   string
+	BodyRespSlice  bool
 	ContentType    string
 	QueryParams    map[string]string
 }
@@ -391,7 +405,8 @@ func serializeRequest(path, httpMethod, funcName string, op *v3.Operation) (*Req
 	valuesReturn := getValuesReturn(op, funcName)
 	if len(valuesReturn) == 2 {
 		p.BodyRespType = valuesReturn[0]
-		if !strings.HasPrefix(valuesReturn[0], "[]") {
+		p.BodyRespSlice = strings.HasPrefix(valuesReturn[0], "[]")
+		if !p.BodyRespSlice {
 			p.BodyRespType = "&" + p.BodyRespType[1:]
 		}
 	}
@@ -0,0 +1,134 @@
+package operations
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"text/template"
+
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/sauterp/egoscale/v3/generator/helpers"
+)
+
+const waitStateTemplate = `
+// {{ .Name }} waits for the {{ .TypeName }} to reach the given state, polling {{ .FuncName }}.
+{{- if .ErrorState }}
+// The wait fails if the {{ .TypeName }} reaches the error state.
+{{- end }}
+func (c Client) {{ .Name }}({{ .Params }}, state {{ .StateType }}, opts ...WaitOpt) (*{{ .TypeName }}, error) {
+	var resource *{{ .TypeName }}
+	err := c.poll(ctx, "{{ .Name }}", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.{{ .FuncName }}({{ .Args }})
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state{{ if .ErrorState }} || res.State == {{ .ErrorState }}{{ end }}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("{{ .Name }}: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+`
+
+// WaitState is used by the wait state template.
+type WaitState struct {
+	Name       string
+	FuncName   string
+	TypeName   string
+	StateType  string
+	ErrorState string
+	Params     string
+	Args       string
+}
+
+// renderWaitState renders a helper waiting for a resource to reach a given state,
+// for GET operations returning a resource holding a state enum.
+// Returns nil on other operations.
+func renderWaitState(funcName, httpMethod string, op *v3.Operation) ([]byte, error) {
+	if !strings.EqualFold(httpMethod, "get") || !strings.HasPrefix(funcName, "Get") {
+		return nil, nil
+	}
+
+	if op.Responses == nil || op.Responses.Codes == nil {
+		return nil, nil
+	}
+	response, ok := op.Responses.Codes.Get("200")
+	if !ok {
+		return nil, nil
+	}
+	media, ok := response.Content.Get("application/json")
+	if !ok || !media.Schema.IsReference() {
+		return nil, nil
+	}
+
+	typeName := helpers.RenderReference(media.Schema.GetReference())
+	// Async operations are awaited with Client.Wait.
+	if typeName == "Operation" {
+		return nil, nil
+	}
+
+	sc, err := media.Schema.BuildSchema()
+	if err != nil {
+		return nil, err
+	}
+	if sc.Properties == nil {
+		return nil, nil
+	}
+
+	stateProxy, ok := sc.Properties.Get("state")
+	if !ok {
+		return nil, nil
+	}
+	state, err := stateProxy.BuildSchema()
+	if err != nil {
+		return nil, err
+	}
+	if len(state.Enum) == 0 {
+		return nil, nil
+	}
+
+	stateType := typeName + "State"
+	if stateProxy.IsReference() {
+		stateType = helpers.RenderReference(stateProxy.GetReference())
+	}
+
+	params := getParameters(op, funcName)
+	if slices.ContainsFunc(params, func(p string) bool { return strings.HasPrefix(p, "opts ") }) {
+		return nil, nil
+	}
+
+	w := WaitState{
+		Name:      "Wait" + strings.TrimPrefix(funcName, "Get") + "State",
+		FuncName:  funcName,
+		TypeName:  typeName,
+		StateType: stateType,
+		Params:    strings.Join(params, ", "),
+		Args:      renderArgs(params),
+	}
+	for _, e := range state.Enum {
+		if e.Value == "error" {
+			w.ErrorState = stateType + helpers.ToCamel(e.Value)
+		}
+	}
+
+	t, err := template.New("waitState").Parse(waitStateTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, w); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
@@ -1,6 +1,6 @@
 module github.com/sauterp/egoscale/v3
 
-go 1.22
+go 1.23
 
 require (
 	github.com/BluntSporks/abbreviation v0.0.0-20150522120346-096cdb48bafa
@@ -31,6 +31,7 @@ require (
 	github.com/spf13/afero v1.11.0 // indirect
 	github.com/spf13/cast v1.6.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
+	github.com/stretchr/objx v0.5.0 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/ulikunitz/xz v0.5.11 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
@@ -0,0 +1,184 @@
+package iam
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// Default service strategies, for PolicyBuilder.Default.
+const (
+	Allow = v3.IAMPolicyDefaultServiceStrategyAllow
+	Deny  = v3.IAMPolicyDefaultServiceStrategyDeny
+)
+
+// PolicyBuilder builds an IAM policy service by service:
+//
+//	policy, err := iam.NewPolicy().
+//		Default(iam.Deny).
+//		Service("dns").AllowAll().
+//		Service("compute").
+//		Deny("operation.startsWith('delete-')", "instance/prod-*").
+//		AllowOperations("list-instances", "get-instance", "delete-instance").
+//		Build()
+//
+// Rules are appended to the service selected last, in the order they are
+// evaluated. Errors are reported by Build.
+type PolicyBuilder struct {
+	policy  v3.IAMPolicy
+	service string
+	errs    []error
+}
+
+// NewPolicy returns a PolicyBuilder of an empty policy, whose default service
+// strategy is Deny.
+func NewPolicy() *PolicyBuilder {
+	return &PolicyBuilder{
+		policy: v3.IAMPolicy{
+			DefaultServiceStrategy: Deny,
+			Services:               map[string]v3.IAMServicePolicy{},
+		},
+	}
+}
+
+// PolicyFrom returns a PolicyBuilder of a copy of a policy, e.g. returned by
+// GetIAMRole, to extend it.
+func PolicyFrom(policy *v3.IAMPolicy) *PolicyBuilder {
+	b := NewPolicy()
+	b.policy.DefaultServiceStrategy = policy.DefaultServiceStrategy
+	for name, service := range policy.Services {
+		service.Rules = slices.Clone(service.Rules)
+		for i := range service.Rules {
+			service.Rules[i].Resources = slices.Clone(service.Rules[i].Resources)
+		}
+		b.policy.Services[name] = service
+	}
+
+	return b
+}
+
+// Default sets the default service strategy, applying to the services not in the policy.
+func (b *PolicyBuilder) Default(strategy v3.IAMPolicyDefaultServiceStrategy) *PolicyBuilder {
+	b.policy.DefaultServiceStrategy = strategy
+	return b
+}
+
+// Service selects the service the next calls apply to, adding it to the
+// policy with the "rules" type if it isn't in it.
+func (b *PolicyBuilder) Service(name string) *PolicyBuilder {
+	b.service = name
+	if _, ok := b.policy.Services[name]; !ok {
+		b.policy.Services[name] = v3.IAMServicePolicy{Type: v3.IAMServicePolicyTypeRules}
+	}
+
+	return b
+}
+
+// AllowAll sets the selected service type to "allow", allowing all its operations.
+func (b *PolicyBuilder) AllowAll() *PolicyBuilder {
+	return b.setType(v3.IAMServicePolicyTypeAllow)
+}
+
+// DenyAll sets the selected service type to "deny", denying all its operations.
+func (b *PolicyBuilder) DenyAll() *PolicyBuilder {
+	return b.setType(v3.IAMServicePolicyTypeDeny)
+}
+
+// Allow appends a rule allowing the operations of the selected service
+// matching an expression and, if set, one of the resources patterns.
+func (b *PolicyBuilder) Allow(expression string, resources ...string) *PolicyBuilder {
+	return b.addRule(v3.IAMServicePolicyRuleActionAllow, expression, resources)
+}
+
+// Deny appends a rule denying the operations of the selected service matching
+// an expression and, if set, one of the resources patterns.
+func (b *PolicyBuilder) Deny(expression string, resources ...string) *PolicyBuilder {
+	return b.addRule(v3.IAMServicePolicyRuleActionDeny, expression, resources)
+}
+
+// AllowOperations appends a rule allowing operations of the selected service by ID.
+func (b *PolicyBuilder) AllowOperations(ids ...string) *PolicyBuilder {
+	return b.addOperationsRule(v3.IAMServicePolicyRuleActionAllow, ids)
+}
+
+// DenyOperations appends a rule denying operations of the selected service by ID.
+func (b *PolicyBuilder) DenyOperations(ids ...string) *PolicyBuilder {
+	return b.addOperationsRule(v3.IAMServicePolicyRuleActionDeny, ids)
+}
+
+// Build returns the policy, or the errors of the builder calls and of the
+// policy validation.
+func (b *PolicyBuilder) Build() (*v3.IAMPolicy, error) {
+	policy := &v3.IAMPolicy{
+		DefaultServiceStrategy: b.policy.DefaultServiceStrategy,
+		Services:               maps.Clone(b.policy.Services),
+	}
+
+	if err := Validate(policy); err != nil {
+		return nil, errors.Join(append(b.errs, err)...)
+	}
+	if len(b.errs) > 0 {
+		return nil, errors.Join(b.errs...)
+	}
+
+	return policy, nil
+}
+
+func (b *PolicyBuilder) setType(t v3.IAMServicePolicyType) *PolicyBuilder {
+	service, ok := b.policy.Services[b.service]
+	if !ok {
+		b.errs = append(b.errs, fmt.Errorf("%s: no service selected", t))
+		return b
+	}
+
+	service.Type = t
+	b.policy.Services[b.service] = service
+
+	return b
+}
+
+func (b *PolicyBuilder) addRule(action v3.IAMServicePolicyRuleAction, expression string, resources []string) *PolicyBuilder {
+	service, ok := b.policy.Services[b.service]
+	if !ok {
+		b.errs = append(b.errs, fmt.Errorf("%s %q: no service selected", action, expression))
+		return b
+	}
+
+	service.Rules = append(slices.Clip(service.Rules), v3.IAMServicePolicyRule{
+		Action:     action,
+		Expression: expression,
+		Resources:  resources,
+	})
+	b.policy.Services[b.service] = service
+
+	return b
+}
+
+func (b *PolicyBuilder) addOperationsRule(action v3.IAMServicePolicyRuleAction, ids []string) *PolicyBuilder {
+	if len(ids) == 0 {
+		b.errs = append(b.errs, fmt.Errorf("services.%s: %s: no operation", b.service, action))
+		return b
+	}
+
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		op, ok := LookupOperation(id)
+		switch {
+		case !ok:
+			b.errs = append(b.errs, fmt.Errorf("services.%s: unknown operation %q", b.service, id))
+		case op.Service != b.service:
+			b.errs = append(b.errs, fmt.Errorf("services.%s: operation %q belongs to service %q", b.service, id, op.Service))
+		}
+		quoted[i] = "'" + id + "'"
+	}
+
+	if len(ids) == 1 {
+		return b.addRule(action, "operation == "+quoted[0], nil)
+	}
+
+	return b.addRule(action, "operation in ["+strings.Join(quoted, ", ")+"]", nil)
+}
@@ -0,0 +1,259 @@
+// Code generated by github.com/egoscale/v3/generator version v0.0.1 DO NOT EDIT.
+
+package iam
+
+// catalog lists the API operations, sorted by ID.
+var catalog = []Operation{
+	{ID: "add-external-source-to-security-group", Service: "compute", Method: "PUT", Path: "/security-group/{id}:add-source"},
+	{ID: "add-instance-protection", Service: "compute", Method: "PUT", Path: "/instance/{id}:add-protection"},
+	{ID: "add-rule-to-security-group", Service: "compute", Method: "POST", Path: "/security-group/{id}/rules"},
+	{ID: "add-service-to-load-balancer", Service: "compute", Method: "POST", Path: "/load-balancer/{id}/service"},
+	{ID: "attach-block-storage-volume-to-instance", Service: "compute", Method: "PUT", Path: "/block-storage/{id}:attach"},
+	{ID: "attach-instance-to-elastic-ip", Service: "compute", Method: "PUT", Path: "/elastic-ip/{id}:attach"},
+	{ID: "attach-instance-to-private-network", Service: "compute", Method: "PUT", Path: "/private-network/{id}:attach"},
+	{ID: "attach-instance-to-security-group", Service: "compute", Method: "PUT", Path: "/security-group/{id}:attach"},
+	{ID: "copy-template", Service: "compute", Method: "POST", Path: "/template/{id}"},
+	{ID: "create-anti-affinity-group", Service: "compute", Method: "POST", Path: "/anti-affinity-group"},
+	{ID: "create-api-key", Service: "iam", Method: "POST", Path: "/api-key"},
+	{ID: "create-block-storage-snapshot", Service: "compute", Method: "POST", Path: "/block-storage/{id}:create-snapshot"},
+	{ID: "create-block-storage-volume", Service: "compute", Method: "POST", Path: "/block-storage"},
+	{ID: "create-dbaas-integration", Service: "dbaas", Method: "POST", Path: "/dbaas-integration"},
+	{ID: "create-dbaas-kafka-schema-registry-acl-config", Service: "dbaas", Method: "POST", Path: "/dbaas-kafka/{name}/schema-registry/acl-config"},
+	{ID: "create-dbaas-kafka-topic-acl-config", Service: "dbaas", Method: "POST", Path: "/dbaas-kafka/{name}/topic/acl-config"},
+	{ID: "create-dbaas-kafka-user", Service: "dbaas", Method: "POST", Path: "/dbaas-kafka/{service-name}/user"},
+	{ID: "create-dbaas-mysql-database", Service: "dbaas", Method: "POST", Path: "/dbaas-mysql/{service-name}/database"},
+	{ID: "create-dbaas-mysql-user", Service: "dbaas", Method: "POST", Path: "/dbaas-mysql/{service-name}/user"},
+	{ID: "create-dbaas-opensearch-user", Service: "dbaas", Method: "POST", Path: "/dbaas-opensearch/{service-name}/user"},
+	{ID: "create-dbaas-pg-connection-pool", Service: "dbaas", Method: "POST", Path: "/dbaas-postgres/{service-name}/connection-pool"},
+	{ID: "create-dbaas-pg-database", Service: "dbaas", Method: "POST", Path: "/dbaas-postgres/{service-name}/database"},
+	{ID: "create-dbaas-pg-upgrade-check", Service: "dbaas", Method: "POST", Path: "/dbaas-postgres/{service}/upgrade-check"},
+	{ID: "create-dbaas-postgres-user", Service: "dbaas", Method: "POST", Path: "/dbaas-postgres/{service-name}/user"},
+	{ID: "create-dbaas-redis-user", Service: "dbaas", Method: "POST", Path: "/dbaas-redis/{service-name}/user"},
+	{ID: "create-dbaas-service-grafana", Service: "dbaas", Method: "POST", Path: "/dbaas-grafana/{name}"},
+	{ID: "create-dbaas-service-kafka", Service: "dbaas", Method: "POST", Path: "/dbaas-kafka/{name}"},
+	{ID: "create-dbaas-service-mysql", Service: "dbaas", Method: "POST", Path: "/dbaas-mysql/{name}"},
+	{ID: "create-dbaas-service-opensearch", Service: "dbaas", Method: "POST", Path: "/dbaas-opensearch/{name}"},
+	{ID: "create-dbaas-service-pg", Service: "dbaas", Method: "POST", Path: "/dbaas-postgres/{name}"},
+	{ID: "create-dbaas-service-redis", Service: "dbaas", Method: "POST", Path: "/dbaas-redis/{name}"},
+	{ID: "create-dbaas-task-migration-check", Service: "dbaas", Method: "POST", Path: "/dbaas-task-migration-check/{service}"},
+	{ID: "create-dns-domain", Service: "dns", Method: "POST", Path: "/dns-domain"},
+	{ID: "create-dns-domain-record", Service: "dns", Method: "POST", Path: "/dns-domain/{domain-id}/record"},
+	{ID: "create-elastic-ip", Service: "compute", Method: "POST", Path: "/elastic-ip"},
+	{ID: "create-iam-role", Service: "iam", Method: "POST", Path: "/iam-role"},
+	{ID: "create-instance", Service: "compute", Method: "POST", Path: "/instance"},
+	{ID: "create-instance-pool", Service: "compute", Method: "POST", Path: "/instance-pool"},
+	{ID: "create-load-balancer", Service: "compute", Method: "POST", Path: "/load-balancer"},
+	{ID: "create-private-network", Service: "compute", Method: "POST", Path: "/private-network"},
+	{ID: "create-security-group", Service: "compute", Method: "POST", Path: "/security-group"},
+	{ID: "create-sks-cluster", Service: "compute", Method: "POST", Path: "/sks-cluster"},
+	{ID: "create-sks-nodepool", Service: "compute", Method: "POST", Path: "/sks-cluster/{id}/nodepool"},
+	{ID: "create-snapshot", Service: "compute", Method: "POST", Path: "/instance/{id}:create-snapshot"},
+	{ID: "delete-anti-affinity-group", Service: "compute", Method: "DELETE", Path: "/anti-affinity-group/{id}"},
+	{ID: "delete-api-key", Service: "iam", Method: "DELETE", Path: "/api-key/{id}"},
+	{ID: "delete-block-storage-snapshot", Service: "compute", Method: "DELETE", Path: "/block-storage-snapshot/{id}"},
+	{ID: "delete-block-storage-volume", Service: "compute", Method: "DELETE", Path: "/block-storage/{id}"},
+	{ID: "delete-dbaas-integration", Service: "dbaas", Method: "DELETE", Path: "/dbaas-integration/{id}"},
+	{ID: "delete-dbaas-kafka-schema-registry-acl-config", Service: "dbaas", Method: "DELETE", Path: "/dbaas-kafka/{name}/schema-registry/acl-config/{acl-id}"},
+	{ID: "delete-dbaas-kafka-topic-acl-config", Service: "dbaas", Method: "DELETE", Path: "/dbaas-kafka/{name}/topic/acl-config/{acl-id}"},
+	{ID: "delete-dbaas-kafka-user", Service: "dbaas", Method: "DELETE", Path: "/dbaas-kafka/{service-name}/user/{username}"},
+	{ID: "delete-dbaas-mysql-database", Service: "dbaas", Method: "DELETE", Path: "/dbaas-mysql/{service-name}/database/{database-name}"},
+	{ID: "delete-dbaas-mysql-user", Service: "dbaas", Method: "DELETE", Path: "/dbaas-mysql/{service-name}/user/{username}"},
+	{ID: "delete-dbaas-opensearch-user", Service: "dbaas", Method: "DELETE", Path: "/dbaas-opensearch/{service-name}/user/{username}"},
+	{ID: "delete-dbaas-pg-connection-pool", Service: "dbaas", Method: "DELETE", Path: "/dbaas-postgres/{service-name}/connection-pool/{connection-pool-name}"},
+	{ID: "delete-dbaas-pg-database", Service: "dbaas", Method: "DELETE", Path: "/dbaas-postgres/{service-name}/database/{database-name}"},
+	{ID: "delete-dbaas-postgres-user", Service: "dbaas", Method: "DELETE", Path: "/dbaas-postgres/{service-name}/user/{username}"},
+	{ID: "delete-dbaas-redis-user", Service: "dbaas", Method: "DELETE", Path: "/dbaas-redis/{service-name}/user/{username}"},
+	{ID: "delete-dbaas-service", Service: "dbaas", Method: "DELETE", Path: "/dbaas-service/{name}"},
+	{ID: "delete-dbaas-service-grafana", Service: "dbaas", Method: "DELETE", Path: "/dbaas-grafana/{name}"},
+	{ID: "delete-dbaas-service-kafka", Service: "dbaas", Method: "DELETE", Path: "/dbaas-kafka/{name}"},
+	{ID: "delete-dbaas-service-mysql", Service: "dbaas", Method: "DELETE", Path: "/dbaas-mysql/{name}"},
+	{ID: "delete-dbaas-service-opensearch", Service: "dbaas", Method: "DELETE", Path: "/dbaas-opensearch/{name}"},
+	{ID: "delete-dbaas-service-pg", Service: "dbaas", Method: "DELETE", Path: "/dbaas-postgres/{name}"},
+	{ID: "delete-dbaas-service-redis", Service: "dbaas", Method: "DELETE", Path: "/dbaas-redis/{name}"},
+	{ID: "delete-dns-domain", Service: "dns", Method: "DELETE", Path: "/dns-domain/{id}"},
+	{ID: "delete-dns-domain-record", Service: "dns", Method: "DELETE", Path: "/dns-domain/{domain-id}/record/{record-id}"},
+	{ID: "delete-elastic-ip", Service: "compute", Method: "DELETE", Path: "/elastic-ip/{id}"},
+	{ID: "delete-iam-role", Service: "iam", Method: "DELETE", Path: "/iam-role/{id}"},
+	{ID: "delete-instance", Service: "compute", Method: "DELETE", Path: "/instance/{id}"},
+	{ID: "delete-instance-pool", Service: "compute", Method: "DELETE", Path: "/instance-pool/{id}"},
+	{ID: "delete-load-balancer", Service: "compute", Method: "DELETE", Path: "/load-balancer/{id}"},
+	{ID: "delete-load-balancer-service", Service: "compute", Method: "DELETE", Path: "/load-balancer/{id}/service/{service-id}"},
+	{ID: "delete-private-network", Service: "compute", Method: "DELETE", Path: "/private-network/{id}"},
+	{ID: "delete-reverse-dns-elastic-ip", Service: "compute", Method: "DELETE", Path: "/reverse-dns/elastic-ip/{id}"},
+	{ID: "delete-reverse-dns-instance", Service: "compute", Method: "DELETE", Path: "/reverse-dns/instance/{id}"},
+	{ID: "delete-rule-from-security-group", Service: "compute", Method: "DELETE", Path: "/security-group/{id}/rules/{rule-id}"},
+	{ID: "delete-security-group", Service: "compute", Method: "DELETE", Path: "/security-group/{id}"},
+	{ID: "delete-sks-cluster", Service: "compute", Method: "DELETE", Path: "/sks-cluster/{id}"},
+	{ID: "delete-sks-nodepool", Service: "compute", Method: "DELETE", Path: "/sks-cluster/{id}/nodepool/{sks-nodepool-id}"},
+	{ID: "delete-snapshot", Service: "compute", Method: "DELETE", Path: "/snapshot/{id}"},
+	{ID: "delete-ssh-key", Service: "compute", Method: "DELETE", Path: "/ssh-key/{name}"},
+	{ID: "delete-template", Service: "compute", Method: "DELETE", Path: "/template/{id}"},
+	{ID: "detach-block-storage-volume", Service: "compute", Method: "PUT", Path: "/block-storage/{id}:detach"},
+	{ID: "detach-instance-from-elastic-ip", Service: "compute", Method: "PUT", Path: "/elastic-ip/{id}:detach"},
+	{ID: "detach-instance-from-private-network", Service: "compute", Method: "PUT", Path: "/private-network/{id}:detach"},
+	{ID: "detach-instance-from-security-group", Service: "compute", Method: "PUT", Path: "/security-group/{id}:detach"},
+	{ID: "evict-instance-pool-members", Service: "compute", Method: "PUT", Path: "/instance-pool/{id}:evict"},
+	{ID: "evict-sks-nodepool-members", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/nodepool/{sks-nodepool-id}:evict"},
+	{ID: "export-snapshot", Service: "compute", Method: "POST", Path: "/snapshot/{id}:export"},
+	{ID: "generate-sks-cluster-kubeconfig", Service: "compute", Method: "POST", Path: "/sks-cluster-kubeconfig/{id}"},
+	{ID: "get-anti-affinity-group", Service: "compute", Method: "GET", Path: "/anti-affinity-group/{id}"},
+	{ID: "get-api-key", Service: "iam", Method: "GET", Path: "/api-key/{id}"},
+	{ID: "get-block-storage-snapshot", Service: "compute", Method: "GET", Path: "/block-storage-snapshot/{id}"},
+	{ID: "get-block-storage-volume", Service: "compute", Method: "GET", Path: "/block-storage/{id}"},
+	{ID: "get-console-proxy-url", Service: "compute", Method: "GET", Path: "/console/{id}"},
+	{ID: "get-dbaas-ca-certificate", Service: "dbaas", Method: "GET", Path: "/dbaas-ca-certificate"},
+	{ID: "get-dbaas-integration", Service: "dbaas", Method: "GET", Path: "/dbaas-integration/{id}"},
+	{ID: "get-dbaas-kafka-acl-config", Service: "dbaas", Method: "GET", Path: "/dbaas-kafka/{name}/acl-config"},
+	{ID: "get-dbaas-migration-status", Service: "dbaas", Method: "GET", Path: "/dbaas-migration-status/{name}"},
+	{ID: "get-dbaas-opensearch-acl-config", Service: "dbaas", Method: "GET", Path: "/dbaas-opensearch/{name}/acl-config"},
+	{ID: "get-dbaas-service-grafana", Service: "dbaas", Method: "GET", Path: "/dbaas-grafana/{name}"},
+	{ID: "get-dbaas-service-kafka", Service: "dbaas", Method: "GET", Path: "/dbaas-kafka/{name}"},
+	{ID: "get-dbaas-service-logs", Service: "dbaas", Method: "POST", Path: "/dbaas-service-logs/{service-name}"},
+	{ID: "get-dbaas-service-metrics", Service: "dbaas", Method: "POST", Path: "/dbaas-service-metrics/{service-name}"},
+	{ID: "get-dbaas-service-mysql", Service: "dbaas", Method: "GET", Path: "/dbaas-mysql/{name}"},
+	{ID: "get-dbaas-service-opensearch", Service: "dbaas", Method: "GET", Path: "/dbaas-opensearch/{name}"},
+	{ID: "get-dbaas-service-pg", Service: "dbaas", Method: "GET", Path: "/dbaas-postgres/{name}"},
+	{ID: "get-dbaas-service-redis", Service: "dbaas", Method: "GET", Path: "/dbaas-redis/{name}"},
+	{ID: "get-dbaas-service-type", Service: "dbaas", Method: "GET", Path: "/dbaas-service-type/{service-type-name}"},
+	{ID: "get-dbaas-settings-grafana", Service: "dbaas", Method: "GET", Path: "/dbaas-settings-grafana"},
+	{ID: "get-dbaas-settings-kafka", Service: "dbaas", Method: "GET", Path: "/dbaas-settings-kafka"},
+	{ID: "get-dbaas-settings-mysql", Service: "dbaas", Method: "GET", Path: "/dbaas-settings-mysql"},
+	{ID: "get-dbaas-settings-opensearch", Service: "dbaas", Method: "GET", Path: "/dbaas-settings-opensearch"},
+	{ID: "get-dbaas-settings-pg", Service: "dbaas", Method: "GET", Path: "/dbaas-settings-pg"},
+	{ID: "get-dbaas-settings-redis", Service: "dbaas", Method: "GET", Path: "/dbaas-settings-redis"},
+	{ID: "get-dbaas-task", Service: "dbaas", Method: "GET", Path: "/dbaas-task/{service}/{id}"},
+	{ID: "get-deploy-target", Service: "compute", Method: "GET", Path: "/deploy-target/{id}"},
+	{ID: "get-dns-domain", Service: "dns", Method: "GET", Path: "/dns-domain/{id}"},
+	{ID: "get-dns-domain-record", Service: "dns", Method: "GET", Path: "/dns-domain/{domain-id}/record/{record-id}"},
+	{ID: "get-dns-domain-zone-file", Service: "dns", Method: "GET", Path: "/dns-domain/{id}/zone"},
+	{ID: "get-elastic-ip", Service: "compute", Method: "GET", Path: "/elastic-ip/{id}"},
+	{ID: "get-iam-organization-policy", Service: "iam", Method: "GET", Path: "/iam-organization-policy"},
+	{ID: "get-iam-role", Service: "iam", Method: "GET", Path: "/iam-role/{id}"},
+	{ID: "get-instance", Service: "compute", Method: "GET", Path: "/instance/{id}"},
+	{ID: "get-instance-pool", Service: "compute", Method: "GET", Path: "/instance-pool/{id}"},
+	{ID: "get-instance-type", Service: "compute", Method: "GET", Path: "/instance-type/{id}"},
+	{ID: "get-load-balancer", Service: "compute", Method: "GET", Path: "/load-balancer/{id}"},
+	{ID: "get-load-balancer-service", Service: "compute", Method: "GET", Path: "/load-balancer/{id}/service/{service-id}"},
+	{ID: "get-operation", Service: "compute", Method: "GET", Path: "/operation/{id}"},
+	{ID: "get-organization", Service: "compute", Method: "GET", Path: "/organization"},
+	{ID: "get-private-network", Service: "compute", Method: "GET", Path: "/private-network/{id}"},
+	{ID: "get-quota", Service: "compute", Method: "GET", Path: "/quota/{entity}"},
+	{ID: "get-reverse-dns-elastic-ip", Service: "compute", Method: "GET", Path: "/reverse-dns/elastic-ip/{id}"},
+	{ID: "get-reverse-dns-instance", Service: "compute", Method: "GET", Path: "/reverse-dns/instance/{id}"},
+	{ID: "get-security-group", Service: "compute", Method: "GET", Path: "/security-group/{id}"},
+	{ID: "get-sks-cluster", Service: "compute", Method: "GET", Path: "/sks-cluster/{id}"},
+	{ID: "get-sks-cluster-authority-cert", Service: "compute", Method: "GET", Path: "/sks-cluster/{id}/authority/{authority}/cert"},
+	{ID: "get-sks-cluster-inspection", Service: "compute", Method: "GET", Path: "/sks-cluster/{id}/inspection"},
+	{ID: "get-sks-nodepool", Service: "compute", Method: "GET", Path: "/sks-cluster/{id}/nodepool/{sks-nodepool-id}"},
+	{ID: "get-snapshot", Service: "compute", Method: "GET", Path: "/snapshot/{id}"},
+	{ID: "get-sos-presigned-url", Service: "sos", Method: "GET", Path: "/sos/{bucket}/presigned-url"},
+	{ID: "get-ssh-key", Service: "compute", Method: "GET", Path: "/ssh-key/{name}"},
+	{ID: "get-template", Service: "compute", Method: "GET", Path: "/template/{id}"},
+	{ID: "list-anti-affinity-groups", Service: "compute", Method: "GET", Path: "/anti-affinity-group"},
+	{ID: "list-api-keys", Service: "iam", Method: "GET", Path: "/api-key"},
+	{ID: "list-block-storage-snapshots", Service: "compute", Method: "GET", Path: "/block-storage-snapshot"},
+	{ID: "list-block-storage-volumes", Service: "compute", Method: "GET", Path: "/block-storage"},
+	{ID: "list-dbaas-integration-settings", Service: "dbaas", Method: "GET", Path: "/dbaas-integration-settings/{integration-type}/{source-type}/{dest-type}"},
+	{ID: "list-dbaas-integration-types", Service: "dbaas", Method: "GET", Path: "/dbaas-integration-types"},
+	{ID: "list-dbaas-service-types", Service: "dbaas", Method: "GET", Path: "/dbaas-service-type"},
+	{ID: "list-dbaas-services", Service: "dbaas", Method: "GET", Path: "/dbaas-service"},
+	{ID: "list-deploy-targets", Service: "compute", Method: "GET", Path: "/deploy-target"},
+	{ID: "list-dns-domain-records", Service: "dns", Method: "GET", Path: "/dns-domain/{domain-id}/record"},
+	{ID: "list-dns-domains", Service: "dns", Method: "GET", Path: "/dns-domain"},
+	{ID: "list-elastic-ips", Service: "compute", Method: "GET", Path: "/elastic-ip"},
+	{ID: "list-events", Service: "compute", Method: "GET", Path: "/event"},
+	{ID: "list-iam-roles", Service: "iam", Method: "GET", Path: "/iam-role"},
+	{ID: "list-instance-pools", Service: "compute", Method: "GET", Path: "/instance-pool"},
+	{ID: "list-instance-types", Service: "compute", Method: "GET", Path: "/instance-type"},
+	{ID: "list-instances", Service: "compute", Method: "GET", Path: "/instance"},
+	{ID: "list-load-balancers", Service: "compute", Method: "GET", Path: "/load-balancer"},
+	{ID: "list-private-networks", Service: "compute", Method: "GET", Path: "/private-network"},
+	{ID: "list-quotas", Service: "compute", Method: "GET", Path: "/quota"},
+	{ID: "list-security-groups", Service: "compute", Method: "GET", Path: "/security-group"},
+	{ID: "list-sks-cluster-deprecated-resources", Service: "compute", Method: "GET", Path: "/sks-cluster-deprecated-resources/{id}"},
+	{ID: "list-sks-cluster-versions", Service: "compute", Method: "GET", Path: "/sks-cluster-version"},
+	{ID: "list-sks-clusters", Service: "compute", Method: "GET", Path: "/sks-cluster"},
+	{ID: "list-snapshots", Service: "compute", Method: "GET", Path: "/snapshot"},
+	{ID: "list-sos-buckets-usage", Service: "sos", Method: "GET", Path: "/sos-buckets-usage"},
+	{ID: "list-ssh-keys", Service: "compute", Method: "GET", Path: "/ssh-key"},
+	{ID: "list-templates", Service: "compute", Method: "GET", Path: "/template"},
+	{ID: "list-zones", Service: "compute", Method: "GET", Path: "/zone"},
+	{ID: "promote-snapshot-to-template", Service: "compute", Method: "POST", Path: "/snapshot/{id}:promote"},
+	{ID: "reboot-instance", Service: "compute", Method: "PUT", Path: "/instance/{id}:reboot"},
+	{ID: "register-ssh-key", Service: "compute", Method: "POST", Path: "/ssh-key"},
+	{ID: "register-template", Service: "compute", Method: "POST", Path: "/template"},
+	{ID: "remove-external-source-from-security-group", Service: "compute", Method: "PUT", Path: "/security-group/{id}:remove-source"},
+	{ID: "remove-instance-protection", Service: "compute", Method: "PUT", Path: "/instance/{id}:remove-protection"},
+	{ID: "reset-dbaas-grafana-user-password", Service: "dbaas", Method: "PUT", Path: "/dbaas-grafana/{service-name}/user/{username}/password/reset"},
+	{ID: "reset-dbaas-kafka-user-password", Service: "dbaas", Method: "PUT", Path: "/dbaas-kafka/{service-name}/user/{username}/password/reset"},
+	{ID: "reset-dbaas-mysql-user-password", Service: "dbaas", Method: "PUT", Path: "/dbaas-mysql/{service-name}/user/{username}/password/reset"},
+	{ID: "reset-dbaas-opensearch-user-password", Service: "dbaas", Method: "PUT", Path: "/dbaas-opensearch/{service-name}/user/{username}/password/reset"},
+	{ID: "reset-dbaas-postgres-user-password", Service: "dbaas", Method: "PUT", Path: "/dbaas-postgres/{service-name}/user/{username}/password/reset"},
+	{ID: "reset-dbaas-redis-user-password", Service: "dbaas", Method: "PUT", Path: "/dbaas-redis/{service-name}/user/{username}/password/reset"},
+	{ID: "reset-elastic-ip-field", Service: "compute", Method: "DELETE", Path: "/elastic-ip/{id}/{field}"},
+	{ID: "reset-instance", Service: "compute", Method: "PUT", Path: "/instance/{id}:reset"},
+	{ID: "reset-instance-field", Service: "compute", Method: "DELETE", Path: "/instance/{id}/{field}"},
+	{ID: "reset-instance-password", Service: "compute", Method: "PUT", Path: "/instance/{id}:reset-password"},
+	{ID: "reset-instance-pool-field", Service: "compute", Method: "DELETE", Path: "/instance-pool/{id}/{field}"},
+	{ID: "reset-load-balancer-field", Service: "compute", Method: "DELETE", Path: "/load-balancer/{id}/{field}"},
+	{ID: "reset-load-balancer-service-field", Service: "compute", Method: "DELETE", Path: "/load-balancer/{id}/service/{service-id}/{field}"},
+	{ID: "reset-private-network-field", Service: "compute", Method: "DELETE", Path: "/private-network/{id}/{field}"},
+	{ID: "reset-sks-cluster-field", Service: "compute", Method: "DELETE", Path: "/sks-cluster/{id}/{field}"},
+	{ID: "reset-sks-nodepool-field", Service: "compute", Method: "DELETE", Path: "/sks-cluster/{id}/nodepool/{sks-nodepool-id}/{field}"},
+	{ID: "resize-block-storage-volume", Service: "compute", Method: "PUT", Path: "/block-storage/{id}:resize-volume"},
+	{ID: "resize-instance-disk", Service: "compute", Method: "PUT", Path: "/instance/{id}:resize-disk"},
+	{ID: "reveal-dbaas-grafana-user-password", Service: "dbaas", Method: "GET", Path: "/dbaas-grafana/{service-name}/user/{username}/password/reveal"},
+	{ID: "reveal-dbaas-kafka-user-password", Service: "dbaas", Method: "GET", Path: "/dbaas-kafka/{service-name}/user/{username}/password/reveal"},
+	{ID: "reveal-dbaas-mysql-user-password", Service: "dbaas", Method: "GET", Path: "/dbaas-mysql/{service-name}/user/{username}/password/reveal"},
+	{ID: "reveal-dbaas-opensearch-user-password", Service: "dbaas", Method: "GET", Path: "/dbaas-opensearch/{service-name}/user/{username}/password/reveal"},
+	{ID: "reveal-dbaas-postgres-user-password", Service: "dbaas", Method: "GET", Path: "/dbaas-postgres/{service-name}/user/{username}/password/reveal"},
+	{ID: "reveal-dbaas-redis-user-password", Service: "dbaas", Method: "GET", Path: "/dbaas-redis/{service-name}/user/{username}/password/reveal"},
+	{ID: "reveal-instance-password", Service: "compute", Method: "GET", Path: "/instance/{id}:password"},
+	{ID: "revert-instance-to-snapshot", Service: "compute", Method: "POST", Path: "/instance/{instance-id}:revert-snapshot"},
+	{ID: "rotate-sks-ccm-credentials", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/rotate-ccm-credentials"},
+	{ID: "rotate-sks-operators-ca", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/rotate-operators-ca"},
+	{ID: "scale-instance", Service: "compute", Method: "PUT", Path: "/instance/{id}:scale"},
+	{ID: "scale-instance-pool", Service: "compute", Method: "PUT", Path: "/instance-pool/{id}:scale"},
+	{ID: "scale-sks-nodepool", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/nodepool/{sks-nodepool-id}:scale"},
+	{ID: "start-dbaas-grafana-maintenance", Service: "dbaas", Method: "PUT", Path: "/dbaas-grafana/{name}/maintenance/start"},
+	{ID: "start-dbaas-kafka-maintenance", Service: "dbaas", Method: "PUT", Path: "/dbaas-kafka/{name}/maintenance/start"},
+	{ID: "start-dbaas-mysql-maintenance", Service: "dbaas", Method: "PUT", Path: "/dbaas-mysql/{name}/maintenance/start"},
+	{ID: "start-dbaas-opensearch-maintenance", Service: "dbaas", Method: "PUT", Path: "/dbaas-opensearch/{name}/maintenance/start"},
+	{ID: "start-dbaas-pg-maintenance", Service: "dbaas", Method: "PUT", Path: "/dbaas-postgres/{name}/maintenance/start"},
+	{ID: "start-dbaas-redis-maintenance", Service: "dbaas", Method: "PUT", Path: "/dbaas-redis/{name}/maintenance/start"},
+	{ID: "start-instance", Service: "compute", Method: "PUT", Path: "/instance/{id}:start"},
+	{ID: "stop-dbaas-mysql-migration", Service: "dbaas", Method: "POST", Path: "/dbaas-mysql/{name}/migration/stop"},
+	{ID: "stop-dbaas-pg-migration", Service: "dbaas", Method: "POST", Path: "/dbaas-postgres/{name}/migration/stop"},
+	{ID: "stop-dbaas-redis-migration", Service: "dbaas", Method: "POST", Path: "/dbaas-redis/{name}/migration/stop"},
+	{ID: "stop-instance", Service: "compute", Method: "PUT", Path: "/instance/{id}:stop"},
+	{ID: "update-block-storage-snapshot", Service: "compute", Method: "PUT", Path: "/block-storage-snapshot/{id}"},
+	{ID: "update-block-storage-volume", Service: "compute", Method: "PUT", Path: "/block-storage/{id}"},
+	{ID: "update-dbaas-integration", Service: "dbaas", Method: "PUT", Path: "/dbaas-integration/{id}"},
+	{ID: "update-dbaas-opensearch-acl-config", Service: "dbaas", Method: "PUT", Path: "/dbaas-opensearch/{name}/acl-config"},
+	{ID: "update-dbaas-pg-connection-pool", Service: "dbaas", Method: "PUT", Path: "/dbaas-postgres/{service-name}/connection-pool/{connection-pool-name}"},
+	{ID: "update-dbaas-postgres-allow-replication", Service: "dbaas", Method: "PUT", Path: "/dbaas-postgres/{service-name}/user/{username}/allow-replication"},
+	{ID: "update-dbaas-service-grafana", Service: "dbaas", Method: "PUT", Path: "/dbaas-grafana/{name}"},
+	{ID: "update-dbaas-service-kafka", Service: "dbaas", Method: "PUT", Path: "/dbaas-kafka/{name}"},
+	{ID: "update-dbaas-service-mysql", Service: "dbaas", Method: "PUT", Path: "/dbaas-mysql/{name}"},
+	{ID: "update-dbaas-service-opensearch", Service: "dbaas", Method: "PUT", Path: "/dbaas-opensearch/{name}"},
+	{ID: "update-dbaas-service-pg", Service: "dbaas", Method: "PUT", Path: "/dbaas-postgres/{name}"},
+	{ID: "update-dbaas-service-redis", Service: "dbaas", Method: "PUT", Path: "/dbaas-redis/{name}"},
+	{ID: "update-dns-domain-record", Service: "dns", Method: "PUT", Path: "/dns-domain/{domain-id}/record/{record-id}"},
+	{ID: "update-elastic-ip", Service: "compute", Method: "PUT", Path: "/elastic-ip/{id}"},
+	{ID: "update-iam-organization-policy", Service: "iam", Method: "PUT", Path: "/iam-organization-policy"},
+	{ID: "update-iam-role", Service: "iam", Method: "PUT", Path: "/iam-role/{id}"},
+	{ID: "update-iam-role-policy", Service: "iam", Method: "PUT", Path: "/iam-role/{id}:policy"},
+	{ID: "update-instance", Service: "compute", Method: "PUT", Path: "/instance/{id}"},
+	{ID: "update-instance-pool", Service: "compute", Method: "PUT", Path: "/instance-pool/{id}"},
+	{ID: "update-load-balancer", Service: "compute", Method: "PUT", Path: "/load-balancer/{id}"},
+	{ID: "update-load-balancer-service", Service: "compute", Method: "PUT", Path: "/load-balancer/{id}/service/{service-id}"},
+	{ID: "update-private-network", Service: "compute", Method: "PUT", Path: "/private-network/{id}"},
+	{ID: "update-private-network-instance-ip", Service: "compute", Method: "PUT", Path: "/private-network/{id}:update-ip"},
+	{ID: "update-reverse-dns-elastic-ip", Service: "compute", Method: "POST", Path: "/reverse-dns/elastic-ip/{id}"},
+	{ID: "update-reverse-dns-instance", Service: "compute", Method: "POST", Path: "/reverse-dns/instance/{id}"},
+	{ID: "update-sks-cluster", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}"},
+	{ID: "update-sks-nodepool", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/nodepool/{sks-nodepool-id}"},
+	{ID: "update-template", Service: "compute", Method: "PUT", Path: "/template/{id}"},
+	{ID: "upgrade-sks-cluster", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/upgrade"},
+	{ID: "upgrade-sks-cluster-service-level", Service: "compute", Method: "PUT", Path: "/sks-cluster/{id}/upgrade-service-level"},
+}
@@ -0,0 +1,994 @@
+package iam
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Variables are the variables an expression can reference.
+var Variables = []string{"operation", "parameters", "resources"}
+
+// Expression is a compiled IAM policy rule expression.
+//
+// Expressions are written in a subset of the Common Expression Language (CEL):
+// literals (strings, numbers, booleans, null and lists), member and index
+// access, the logical, comparison, arithmetic and "in" operators, the ternary
+// operator, the has() and size() functions, and the startsWith(), endsWith(),
+// contains(), matches() and size() methods.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Compile parses an expression, checking that it only references known variables.
+func Compile(source string) (*Expression, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at offset %d", t.value, t.pos)
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Eval evaluates the expression against the values of the variables, which must
+// result in a boolean.
+func (e *Expression) Eval(vars map[string]any) (bool, error) {
+	v, err := e.root.eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression results in %s, not a boolean", typeName(v))
+	}
+
+	return b, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenPunct
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+var punctuations = []string{"&&", "||", "==", "!=", "<=", ">=", "(", ")", "[", "]", ".", ",", "?", ":", "!", "<", ">", "+", "-", "*", "/", "%"}
+
+func lex(source string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(source) && source[j] != c; j++ {
+				if source[j] != '\\' {
+					b.WriteByte(source[j])
+					continue
+				}
+				if j++; j == len(source) {
+					break
+				}
+				switch source[j] {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				default:
+					b.WriteByte(source[j])
+				}
+			}
+			if j >= len(source) {
+				return nil, fmt.Errorf("unterminated string at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: b.String(), pos: i})
+			i = j + 1
+
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(source) && (source[j] >= '0' && source[j] <= '9' || source[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: source[i:j], pos: i})
+			i = j
+
+		case c == '_' || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(source) && (source[j] == '_' || unicode.IsLetter(rune(source[j])) || unicode.IsDigit(rune(source[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, value: source[i:j], pos: i})
+			i = j
+
+		default:
+			matched := false
+			for _, p := range punctuations {
+				if strings.HasPrefix(source[i:], p) {
+					tokens = append(tokens, token{kind: tokenPunct, value: p, pos: i})
+					i += len(p)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+type exprParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *exprParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is the given punctuation or keyword.
+func (p *exprParser) accept(value string) bool {
+	if t := p.peek(); (t.kind == tokenPunct || t.kind == tokenIdent) && t.value == value {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expect(value string) error {
+	if !p.accept(value) {
+		t := p.peek()
+		if t.kind == tokenEOF {
+			return fmt.Errorf("expected %q at end of expression", value)
+		}
+		return fmt.Errorf("expected %q at offset %d, got %q", value, t.pos, t.value)
+	}
+	return nil
+}
+
+func (p *exprParser) parseTernary() (node, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return cond, nil
+	}
+
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ternaryNode{cond: cond, then: then, otherwise: otherwise}, nil
+}
+
+// binaryPrecedences lists the binary operators by increasing precedence.
+var binaryPrecedences = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">=", "in"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (node, error) {
+	if level == len(binaryPrecedences) {
+		return p.parseUnary()
+	}
+
+	x, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var op string
+		for _, candidate := range binaryPrecedences[level] {
+			if p.accept(candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return x, nil
+		}
+
+		y, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{op: op, x: x, y: y}
+	}
+}
+
+func (p *exprParser) parseUnary() (node, error) {
+	for _, op := range []string{"!", "-"} {
+		if p.accept(op) {
+			x, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return &unaryNode{op: op, x: x}, nil
+		}
+	}
+
+	return p.parsePostfix()
+}
+
+func (p *exprParser) parsePostfix() (node, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != tokenIdent {
+				return nil, fmt.Errorf("expected a field name at offset %d", t.pos)
+			}
+			if p.accept("(") {
+				args, err := p.parseList(")")
+				if err != nil {
+					return nil, err
+				}
+				if x, err = newMethodNode(t.value, x, args); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			x = &memberNode{x: x, field: t.value}
+
+		case p.accept("["):
+			index, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			x = &indexNode{x: x, index: index}
+
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *exprParser) parsePrimary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenString:
+		return &literalNode{value: t.value}, nil
+
+	case tokenNumber:
+		if n, err := strconv.ParseInt(t.value, 10, 64); err == nil {
+			return &literalNode{value: n}, nil
+		}
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at offset %d", t.value, t.pos)
+		}
+		return &literalNode{value: f}, nil
+
+	case tokenIdent:
+		switch t.value {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		}
+
+		if p.accept("(") {
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			return newFunctionNode(t.value, args)
+		}
+
+		for _, v := range Variables {
+			if t.value == v {
+				return &identNode{name: t.value}, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown variable %q at offset %d", t.value, t.pos)
+
+	case tokenPunct:
+		switch t.value {
+		case "(":
+			x, err := p.parseTernary()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		case "[":
+			elems, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{elems: elems}, nil
+		}
+		return nil, fmt.Errorf("unexpected %q at offset %d", t.value, t.pos)
+
+	default:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+}
+
+// parseList parses comma separated expressions up to the closing punctuation.
+func (p *exprParser) parseList(closing string) ([]node, error) {
+	var elems []node
+	if p.accept(closing) {
+		return elems, nil
+	}
+
+	for {
+		x, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, x)
+
+		if p.accept(closing) {
+			return elems, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+type node interface {
+	eval(vars map[string]any) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(map[string]any) (any, error) {
+	return n.value, nil
+}
+
+type identNode struct {
+	name string
+}
+
+func (n *identNode) eval(vars map[string]any) (any, error) {
+	v, ok := vars[n.name]
+	if !ok {
+		return nil, fmt.Errorf("undefined variable %q", n.name)
+	}
+	return normalize(v), nil
+}
+
+type listNode struct {
+	elems []node
+}
+
+func (n *listNode) eval(vars map[string]any) (any, error) {
+	list := make([]any, len(n.elems))
+	for i, elem := range n.elems {
+		v, err := elem.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = v
+	}
+	return list, nil
+}
+
+type memberNode struct {
+	x     node
+	field string
+}
+
+func (n *memberNode) eval(vars map[string]any) (any, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := x.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot access field %q of %s", n.field, typeName(x))
+	}
+	v, ok := m[n.field]
+	if !ok {
+		return nil, fmt.Errorf("no such key %q", n.field)
+	}
+
+	return normalize(v), nil
+}
+
+type indexNode struct {
+	x     node
+	index node
+}
+
+func (n *indexNode) eval(vars map[string]any) (any, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x := x.(type) {
+	case map[string]any:
+		key, ok := index.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid map key of type %s", typeName(index))
+		}
+		v, ok := x[key]
+		if !ok {
+			return nil, fmt.Errorf("no such key %q", key)
+		}
+		return normalize(v), nil
+	case []any:
+		i, ok := index.(int64)
+		if !ok {
+			return nil, fmt.Errorf("invalid list index of type %s", typeName(index))
+		}
+		if i < 0 || i >= int64(len(x)) {
+			return nil, fmt.Errorf("index %d out of range", i)
+		}
+		return normalize(x[i]), nil
+	default:
+		return nil, fmt.Errorf("cannot index %s", typeName(x))
+	}
+}
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+func (n *unaryNode) eval(vars map[string]any) (any, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x := x.(type) {
+	case bool:
+		if n.op == "!" {
+			return !x, nil
+		}
+	case int64:
+		if n.op == "-" {
+			return -x, nil
+		}
+	case float64:
+		if n.op == "-" {
+			return -x, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid operand %s for %q", typeName(x), n.op)
+}
+
+type binaryNode struct {
+	op   string
+	x, y node
+}
+
+func (n *binaryNode) eval(vars map[string]any) (any, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	// The logical operators short-circuit.
+	switch n.op {
+	case "&&", "||":
+		bx, ok := x.(bool)
+		if !ok {
+			return nil, fmt.Errorf("invalid operand %s for %q", typeName(x), n.op)
+		}
+		if bx == (n.op == "||") {
+			return bx, nil
+		}
+		y, err := n.y.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		by, ok := y.(bool)
+		if !ok {
+			return nil, fmt.Errorf("invalid operand %s for %q", typeName(y), n.op)
+		}
+		return by, nil
+	}
+
+	y, err := n.y.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+	case "in":
+		switch y := y.(type) {
+		case []any:
+			for _, elem := range y {
+				if equal(x, elem) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case map[string]any:
+			key, ok := x.(string)
+			if !ok {
+				return false, nil
+			}
+			_, ok = y[key]
+			return ok, nil
+		default:
+			return nil, fmt.Errorf("invalid operand %s for %q", typeName(y), n.op)
+		}
+	case "<", "<=", ">", ">=":
+		c, err := compare(x, y)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		default:
+			return c >= 0, nil
+		}
+	default:
+		return arithmetic(n.op, x, y)
+	}
+}
+
+type ternaryNode struct {
+	cond, then, otherwise node
+}
+
+func (n *ternaryNode) eval(vars map[string]any) (any, error) {
+	cond, err := n.cond.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	b, ok := cond.(bool)
+	if !ok {
+		return nil, fmt.Errorf("invalid condition of type %s", typeName(cond))
+	}
+	if b {
+		return n.then.eval(vars)
+	}
+	return n.otherwise.eval(vars)
+}
+
+// hasNode implements the has() macro, testing the presence of a field.
+type hasNode struct {
+	member *memberNode
+}
+
+func (n *hasNode) eval(vars map[string]any) (any, error) {
+	x, err := n.member.x.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := x.(map[string]any)
+	if !ok {
+		return false, nil
+	}
+	_, ok = m[n.member.field]
+
+	return ok, nil
+}
+
+type callNode struct {
+	name string
+	args []node
+	fn   func(args []any) (any, error)
+}
+
+func (n *callNode) eval(vars map[string]any) (any, error) {
+	args := make([]any, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	v, err := n.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", n.name, err)
+	}
+
+	return v, nil
+}
+
+// walk calls fn for a node and all its sub-nodes, depth-first.
+func walk(n node, fn func(node)) {
+	fn(n)
+
+	var children []node
+	switch n := n.(type) {
+	case *listNode:
+		children = n.elems
+	case *memberNode:
+		children = []node{n.x}
+	case *indexNode:
+		children = []node{n.x, n.index}
+	case *unaryNode:
+		children = []node{n.x}
+	case *binaryNode:
+		children = []node{n.x, n.y}
+	case *ternaryNode:
+		children = []node{n.cond, n.then, n.otherwise}
+	case *hasNode:
+		children = []node{n.member}
+	case *callNode:
+		children = n.args
+	}
+	for _, child := range children {
+		walk(child, fn)
+	}
+}
+
+func newFunctionNode(name string, args []node) (node, error) {
+	switch name {
+	case "has":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("has() takes a single argument")
+		}
+		member, ok := args[0].(*memberNode)
+		if !ok {
+			return nil, fmt.Errorf("has() argument must be a field selection")
+		}
+		return &hasNode{member: member}, nil
+	case "size":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("size() takes a single argument")
+		}
+		return &callNode{name: name, args: args, fn: sizeOf}, nil
+	default:
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+}
+
+func newMethodNode(name string, target node, args []node) (node, error) {
+	all := append([]node{target}, args...)
+
+	switch name {
+	case "size":
+		if len(args) != 0 {
+			return nil, fmt.Errorf("size() takes no argument")
+		}
+		return &callNode{name: name, args: all, fn: sizeOf}, nil
+	case "startsWith", "endsWith", "contains", "matches":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%s() takes a single argument", name)
+		}
+		if lit, ok := args[0].(*literalNode); ok && name == "matches" {
+			pattern, ok := lit.value.(string)
+			if !ok {
+				return nil, fmt.Errorf("matches() argument must be a string")
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("matches(): %w", err)
+			}
+		}
+		return &callNode{name: name, args: all, fn: stringMethod(name)}, nil
+	default:
+		return nil, fmt.Errorf("unknown method %q", name)
+	}
+}
+
+func sizeOf(args []any) (any, error) {
+	switch v := args[0].(type) {
+	case string:
+		return int64(len([]rune(v))), nil
+	case []any:
+		return int64(len(v)), nil
+	case map[string]any:
+		return int64(len(v)), nil
+	default:
+		return nil, fmt.Errorf("invalid argument of type %s", typeName(v))
+	}
+}
+
+func stringMethod(name string) func(args []any) (any, error) {
+	return func(args []any) (any, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid target of type %s", typeName(args[0]))
+		}
+		arg, ok := args[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid argument of type %s", typeName(args[1]))
+		}
+
+		switch name {
+		case "startsWith":
+			return strings.HasPrefix(s, arg), nil
+		case "endsWith":
+			return strings.HasSuffix(s, arg), nil
+		case "contains":
+			return strings.Contains(s, arg), nil
+		default:
+			re, err := regexp.Compile(arg)
+			if err != nil {
+				return nil, err
+			}
+			return re.MatchString(s), nil
+		}
+	}
+}
+
+// normalize converts the values provided to expressions to the types they
+// handle: int64, float64, string, bool, nil, []any and map[string]any.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case nil, bool, string, int64, float64, []any, map[string]any:
+		return v
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case []string:
+		list := make([]any, len(v))
+		for i, s := range v {
+			list[i] = s
+		}
+		return list
+	case map[string]string:
+		m := make(map[string]any, len(v))
+		for k, s := range v {
+			m[k] = s
+		}
+		return m
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Slice:
+		list := make([]any, rv.Len())
+		for i := range list {
+			list[i] = rv.Index(i).Interface()
+		}
+		return list
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return v
+		}
+		m := make(map[string]any, rv.Len())
+		for _, k := range rv.MapKeys() {
+			m[k.String()] = rv.MapIndex(k).Interface()
+		}
+		return m
+	default:
+		return v
+	}
+}
+
+func equal(x, y any) bool {
+	x, y = normalize(x), normalize(y)
+
+	if fx, fy, ok := numbers(x, y); ok {
+		return fx == fy
+	}
+
+	switch x := x.(type) {
+	case []any:
+		y, ok := y.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		y, ok := y.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			if w, ok := y[k]; !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	default:
+		return x == y
+	}
+}
+
+// numbers returns two numeric values as floats.
+func numbers(x, y any) (float64, float64, bool) {
+	toFloat := func(v any) (float64, bool) {
+		switch v := v.(type) {
+		case int64:
+			return float64(v), true
+		case float64:
+			return v, true
+		default:
+			return 0, false
+		}
+	}
+
+	fx, okx := toFloat(x)
+	fy, oky := toFloat(y)
+
+	return fx, fy, okx && oky
+}
+
+func compare(x, y any) (int, error) {
+	if fx, fy, ok := numbers(x, y); ok {
+		switch {
+		case fx < fy:
+			return -1, nil
+		case fx > fy:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	}
+
+	sx, okx := x.(string)
+	sy, oky := y.(string)
+	if okx && oky {
+		return strings.Compare(sx, sy), nil
+	}
+
+	return 0, fmt.Errorf("cannot compare %s and %s", typeName(x), typeName(y))
+}
+
+func arithmetic(op string, x, y any) (any, error) {
+	if ix, ok := x.(int64); ok {
+		if iy, ok := y.(int64); ok {
+			switch op {
+			case "+":
+				return ix + iy, nil
+			case "-":
+				return ix - iy, nil
+			case "*":
+				return ix * iy, nil
+			case "/", "%":
+				if iy == 0 {
+					return nil, fmt.Errorf("division by zero")
+				}
+				if op == "/" {
+					return ix / iy, nil
+				}
+				return ix % iy, nil
+			}
+		}
+	}
+
+	if fx, fy, ok := numbers(x, y); ok {
+		switch op {
+		case "+":
+			return fx + fy, nil
+		case "-":
+			return fx - fy, nil
+		case "*":
+			return fx * fy, nil
+		case "/":
+			return fx / fy, nil
+		}
+	}
+
+	if op == "+" {
+		switch x := x.(type) {
+		case string:
+			if y, ok := y.(string); ok {
+				return x + y, nil
+			}
+		case []any:
+			if y, ok := y.([]any); ok {
+				return append(append([]any{}, x...), y...), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("invalid operands %s and %s for %q", typeName(x), typeName(y), op)
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case string:
+		return "string"
+	case int64:
+		return "int"
+	case float64:
+		return "double"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "map"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
@@ -0,0 +1,316 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+func TestExpression(t *testing.T) {
+	vars := Request{
+		Operation: "create-instance",
+		Parameters: map[string]any{
+			"name":      "prod-web-1",
+			"disk-size": 50,
+			"labels":    map[string]string{"env": "prod"},
+			"zones":     []string{"ch-gva-2", "de-fra-1"},
+		},
+	}.vars()
+
+	for expr, expected := range map[string]bool{
+		`true`:                           true,
+		`operation == 'create-instance'`: true,
+		`operation in ["get-instance", "list-instances"]`:               false,
+		`parameters.name.startsWith('prod-')`:                           true,
+		`parameters.name.matches('^prod-[a-z]+-[0-9]+$')`:               true,
+		`parameters['disk-size'] > 20 && parameters['disk-size'] <= 50`: true,
+		`parameters['disk-size'] * 2 == 100.0`:                          true,
+		`has(parameters.labels) && parameters.labels.env == 'prod'`:     true,
+		`has(parameters.template) && parameters.template == 'x'`:        false,
+		`'env' in parameters.labels && size(parameters.zones) == 2`:     true,
+		`parameters.zones[1].endsWith('fra-1') ? true : false`:          true,
+		`!(operation.contains('delete') || resources.size() > 0)`:       true,
+	} {
+		e, err := Compile(expr)
+		require.NoError(t, err, expr)
+
+		actual, err := e.Eval(vars)
+		require.NoError(t, err, expr)
+		require.Equal(t, expected, actual, expr)
+	}
+
+	for _, expr := range []string{
+		``,
+		`operation ==`,
+		`user == 'admin'`,
+		`operation.lower() == 'x'`,
+		`parameters.name.matches('[')`,
+		`'unterminated`,
+		`(true`,
+		`operation @ 1`,
+	} {
+		_, err := Compile(expr)
+		require.Error(t, err, expr)
+	}
+
+	for _, expr := range []string{
+		`operation`,
+		`parameters.missing == 1`,
+		`parameters.name > 1`,
+	} {
+		e, err := Compile(expr)
+		require.NoError(t, err, expr)
+		_, err = e.Eval(vars)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(&v3.IAMPolicy{
+		DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyDeny,
+		Services: map[string]v3.IAMServicePolicy{
+			"dns": {Type: v3.IAMServicePolicyTypeAllow},
+			"compute": {Type: v3.IAMServicePolicyTypeRules, Rules: []v3.IAMServicePolicyRule{
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation.startsWith('get-')"},
+			}},
+		},
+	}))
+
+	err := Validate(&v3.IAMPolicy{
+		DefaultServiceStrategy: "maybe",
+		Services: map[string]v3.IAMServicePolicy{
+			"dns": {Type: v3.IAMServicePolicyTypeAllow, Rules: []v3.IAMServicePolicyRule{
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "true"},
+			}},
+			"compute": {Type: v3.IAMServicePolicyTypeRules, Rules: []v3.IAMServicePolicyRule{
+				{Action: "permit", Expression: "true"},
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation =="},
+				{Action: v3.IAMServicePolicyRuleActionDeny},
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "true", Resources: []string{"["}},
+			}},
+		},
+	})
+	require.Error(t, err)
+	for _, msg := range []string{
+		`default-service-strategy: invalid value "maybe"`,
+		`services.dns: rules are only supported by the "rules" type`,
+		`services.compute.rules[0]: invalid action "permit"`,
+		`services.compute.rules[1]: expression:`,
+		`services.compute.rules[2]: missing expression`,
+		`services.compute.rules[3]: resource "["`,
+	} {
+		require.Contains(t, err.Error(), msg)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	org := &v3.IAMPolicy{
+		DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyAllow,
+		Services: map[string]v3.IAMServicePolicy{
+			"sos": {Type: v3.IAMServicePolicyTypeDeny},
+			"compute": {Type: v3.IAMServicePolicyTypeRules, Rules: []v3.IAMServicePolicyRule{
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation == 'create-instance' && parameters['disk-size'] > 100"},
+			}},
+		},
+	}
+	role := &v3.IAMPolicy{
+		DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyDeny,
+		Services: map[string]v3.IAMServicePolicy{
+			"sos": {Type: v3.IAMServicePolicyTypeAllow},
+			"dns": {Type: v3.IAMServicePolicyTypeAllow},
+			"compute": {Type: v3.IAMServicePolicyTypeRules, Rules: []v3.IAMServicePolicyRule{
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation.startsWith('delete-')", Resources: []string{"instance/prod-*"}},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation.startsWith('delete-') || operation.startsWith('get-')"},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation == 'create-instance' && parameters.name.startsWith('dev-')"},
+			}},
+		},
+	}
+
+	tests := []struct {
+		req      Request
+		expected Decision
+	}{
+		{
+			req:      Request{Service: "sos", Operation: "create-sos-bucket"},
+			expected: Decision{Allowed: false, Policy: PolicyOrganization, Service: "sos", Rule: -1},
+		},
+		{
+			req:      Request{Service: "dns", Operation: "create-dns-domain"},
+			expected: Decision{Allowed: true, Policy: PolicyRole, Service: "dns", Rule: -1},
+		},
+		{
+			req:      Request{Service: "dbaas", Operation: "list-dbaas-services"},
+			expected: Decision{Allowed: false, Policy: PolicyRole, Service: "dbaas", Rule: -1},
+		},
+		{
+			req:      Request{Service: "compute", Operation: "delete-instance", Resource: "instance/prod-web-1"},
+			expected: Decision{Allowed: false, Policy: PolicyRole, Service: "compute", Rule: 0},
+		},
+		{
+			req:      Request{Service: "compute", Operation: "delete-instance", Resource: "instance/dev-web-1"},
+			expected: Decision{Allowed: true, Policy: PolicyRole, Service: "compute", Rule: 1},
+		},
+		{
+			req: Request{Service: "compute", Operation: "create-instance", Parameters: map[string]any{
+				"name": "dev-web-1", "disk-size": 200,
+			}},
+			expected: Decision{Allowed: false, Policy: PolicyOrganization, Service: "compute", Rule: 0},
+		},
+		{
+			req: Request{Service: "compute", Operation: "create-instance", Parameters: map[string]any{
+				"name": "dev-web-1", "disk-size": 50,
+			}},
+			expected: Decision{Allowed: true, Policy: PolicyRole, Service: "compute", Rule: 2},
+		},
+		{
+			req: Request{Service: "compute", Operation: "create-instance", Parameters: map[string]any{
+				"name": "prod-web-1", "disk-size": 50,
+			}},
+			expected: Decision{Allowed: false, Policy: PolicyRole, Service: "compute", Rule: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		actual, err := Evaluate(org, role, tt.req)
+		require.NoError(t, err, tt.req.Operation)
+		actual.Reason = ""
+		require.Equal(t, tt.expected, actual, tt.req)
+	}
+
+	_, err := EvaluateRole(role, Request{Service: "compute", Operation: "create-instance"})
+	require.Error(t, err)
+
+	decision, err := Evaluate(nil, nil, Request{Service: "compute", Operation: "delete-instance"})
+	require.NoError(t, err)
+	require.True(t, decision.Allowed)
+}
+
+func TestCatalog(t *testing.T) {
+	require.Equal(t, []string{"compute", "dbaas", "dns", "iam", "sos"}, Services())
+
+	op, ok := LookupOperation("create-dns-domain-record")
+	require.True(t, ok)
+	require.Equal(t, Operation{ID: "create-dns-domain-record", Service: "dns", Method: "POST", Path: "/dns-domain/{domain-id}/record"}, op)
+
+	_, ok = LookupOperation("create-dns-domain-records")
+	require.False(t, ok)
+
+	for _, op := range Operations("iam") {
+		require.Equal(t, "iam", op.Service)
+	}
+	require.Contains(t, Operations("iam"), Operation{ID: "create-api-key", Service: "iam", Method: "POST", Path: "/api-key"})
+}
+
+func TestPolicyBuilder(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	policy, err := NewPolicy().
+		Default(Deny).
+		Service("dns").AllowAll().
+		Service("sos").DenyAll().
+		Service("compute").
+		Deny("operation.startsWith('delete-')", "instance/prod-*").
+		AllowOperations("list-instances", "get-instance").
+		Allow("operation == 'create-instance' && parameters.name.startsWith('dev-')").
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, &v3.IAMPolicy{
+		DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyDeny,
+		Services: map[string]v3.IAMServicePolicy{
+			"dns": {Type: v3.IAMServicePolicyTypeAllow},
+			"sos": {Type: v3.IAMServicePolicyTypeDeny},
+			"compute": {Type: v3.IAMServicePolicyTypeRules, Rules: []v3.IAMServicePolicyRule{
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation.startsWith('delete-')", Resources: []string{"instance/prod-*"}},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation in ['list-instances', 'get-instance']"},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation == 'create-instance' && parameters.name.startsWith('dev-')"},
+			}},
+		},
+	}, policy)
+
+	op, err := client.CreateIAMRole(ctx, v3.CreateIAMRoleRequest{Name: "dev", Policy: policy})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+
+	role, err := client.GetIAMRole(ctx, op.Reference.ID)
+	require.NoError(t, err)
+	require.Equal(t, policy, role.Policy)
+
+	extended, err := PolicyFrom(role.Policy).
+		Service("compute").DenyOperations("delete-instance").
+		Build()
+	require.NoError(t, err)
+	require.Len(t, extended.Services["compute"].Rules, 4)
+	require.Len(t, role.Policy.Services["compute"].Rules, 3)
+
+	_, err = NewPolicy().
+		Allow("true").
+		Service("compute").AllowOperations("create-dns-domain", "create-instances").
+		Service("dns").Allow("operation ==").
+		Build()
+	require.Error(t, err)
+	for _, msg := range []string{
+		`allow "true": no service selected`,
+		`services.compute: operation "create-dns-domain" belongs to service "dns"`,
+		`services.compute: unknown operation "create-instances"`,
+		`services.dns.rules[0]: expression:`,
+	} {
+		require.Contains(t, err.Error(), msg)
+	}
+}
+
+func TestLint(t *testing.T) {
+	policy := &v3.IAMPolicy{
+		DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyAllow,
+		Services: map[string]v3.IAMServicePolicy{
+			"dns":     {Type: v3.IAMServicePolicyTypeAllow},
+			"storage": {Type: v3.IAMServicePolicyTypeDeny},
+			"compute": {Type: v3.IAMServicePolicyTypeRules, Rules: []v3.IAMServicePolicyRule{
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation.startsWith('delete-')", Resources: []string{"instance/prod-*"}},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation.startsWith('get-') || operation.startsWith('list-')"},
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation in ['get-instance', 'list-instances']"},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation in ['create-instances', 'create-dns-domain']"},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "operation.endsWith('-nothing')"},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "parameters.name.startsWith('dev-')"},
+				{Action: v3.IAMServicePolicyRuleActionAllow, Expression: "true"},
+				{Action: v3.IAMServicePolicyRuleActionDeny, Expression: "operation == 'delete-instance'", Resources: []string{"instance/*"}},
+			}},
+		},
+	}
+
+	findings, err := Lint(policy, PolicyRole)
+	require.NoError(t, err)
+
+	actual := make([]string, len(findings))
+	for i, f := range findings {
+		actual[i] = f.String()
+	}
+	require.Equal(t, []string{
+		"default service strategy allows all the services not in the policy",
+		`services.compute.rules[2]: unreachable, its operations are all matched by previous rules`,
+		`services.compute.rules[3]: unknown operation "create-instances"`,
+		`services.compute.rules[3]: operation "create-dns-domain" belongs to service "dns"`,
+		`services.compute.rules[4]: matches no operation of the service`,
+		`services.compute.rules[6]: allows all the operations of the service`,
+		`services.compute.rules[7]: unreachable, all the operations are matched by previous rules`,
+		`services.dns: allows all the operations of the service`,
+		`services.storage: unknown service`,
+	}, actual)
+
+	findings, err = Lint(policy, PolicyOrganization)
+	require.NoError(t, err)
+	require.Len(t, findings, 6)
+
+	_, err = Lint(&v3.IAMPolicy{DefaultServiceStrategy: "maybe"}, PolicyRole)
+	require.Error(t, err)
+}
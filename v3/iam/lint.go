@@ -0,0 +1,203 @@
+package iam
+
+import (
+	"fmt"
+	"slices"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// Finding is an issue of a policy reported by Lint.
+type Finding struct {
+	// Service is the policy service of the issue, empty if it concerns the
+	// whole policy.
+	Service string
+	// Rule is the index of the rule of the issue, or -1 if it concerns the
+	// whole service.
+	Rule int
+	// Message describes the issue.
+	Message string
+}
+
+// String returns the finding prefixed by its location in the policy.
+func (f Finding) String() string {
+	switch {
+	case f.Service == "":
+		return f.Message
+	case f.Rule < 0:
+		return fmt.Sprintf("services.%s: %s", f.Service, f.Message)
+	default:
+		return fmt.Sprintf("services.%s.rules[%d]: %s", f.Service, f.Rule, f.Message)
+	}
+}
+
+// Lint reports the issues of a policy of a kind (PolicyOrganization or
+// PolicyRole) the API doesn't reject, or an error if the policy is invalid:
+//
+//   - services and operations unknown to the catalog, or operations referenced
+//     in the rules of another service,
+//   - unreachable rules, whose operations are all matched by previous rules,
+//   - overly broad allows in Role policies: allowing the services not in the
+//     policy, all the operations of a service, or all the operations of a
+//     service with a rule.
+//
+// The operations matched by a rule are only known if its expression depends on
+// the "operation" variable alone and it doesn't restrict resources.
+func Lint(policy *v3.IAMPolicy, kind string) ([]Finding, error) {
+	if err := Validate(policy); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	if kind == PolicyRole && policy.DefaultServiceStrategy == v3.IAMPolicyDefaultServiceStrategyAllow {
+		findings = append(findings, Finding{
+			Rule:    -1,
+			Message: "default service strategy allows all the services not in the policy",
+		})
+	}
+
+	services := Services()
+	for _, name := range serviceNames(policy) {
+		service := policy.Services[name]
+
+		if !slices.Contains(services, name) {
+			findings = append(findings, Finding{Service: name, Rule: -1, Message: "unknown service"})
+			continue
+		}
+
+		if kind == PolicyRole && service.Type == v3.IAMServicePolicyTypeAllow {
+			findings = append(findings, Finding{Service: name, Rule: -1, Message: "allows all the operations of the service"})
+		}
+
+		findings = append(findings, lintRules(name, service.Rules, kind)...)
+	}
+
+	return findings, nil
+}
+
+// lintRules reports the issues of the rules of a known service.
+func lintRules(service string, rules []v3.IAMServicePolicyRule, kind string) []Finding {
+	var (
+		findings []Finding
+		ops      = Operations(service)
+		// matched holds the operations matched by the previous rules.
+		matched = make(map[string]bool)
+	)
+
+	for i, rule := range rules {
+		// Validated by Lint.
+		expr, _ := Compile(rule.Expression)
+
+		n := len(findings)
+		for _, id := range operationLiterals(expr) {
+			if op, ok := LookupOperation(id); !ok {
+				findings = append(findings, Finding{Service: service, Rule: i, Message: fmt.Sprintf("unknown operation %q", id)})
+			} else if op.Service != service {
+				findings = append(findings, Finding{Service: service, Rule: i, Message: fmt.Sprintf("operation %q belongs to service %q", id, op.Service)})
+			}
+		}
+		hasUnknownOperations := len(findings) > n
+
+		if len(matched) == len(ops) {
+			findings = append(findings, Finding{Service: service, Rule: i, Message: "unreachable, all the operations are matched by previous rules"})
+			continue
+		}
+
+		if len(rule.Resources) > 0 || !dependsOnOperationOnly(expr) {
+			continue
+		}
+
+		matches, err := matchOperations(expr, ops)
+		if err != nil {
+			findings = append(findings, Finding{Service: service, Rule: i, Message: err.Error()})
+			continue
+		}
+
+		switch {
+		case len(matches) == 0:
+			if !hasUnknownOperations {
+				findings = append(findings, Finding{Service: service, Rule: i, Message: "matches no operation of the service"})
+			}
+		case !slices.ContainsFunc(matches, func(id string) bool { return !matched[id] }):
+			findings = append(findings, Finding{Service: service, Rule: i, Message: "unreachable, its operations are all matched by previous rules"})
+		case kind == PolicyRole && rule.Action == v3.IAMServicePolicyRuleActionAllow && len(matches) == len(ops):
+			findings = append(findings, Finding{Service: service, Rule: i, Message: "allows all the operations of the service"})
+		}
+
+		for _, id := range matches {
+			matched[id] = true
+		}
+	}
+
+	return findings
+}
+
+// matchOperations returns the IDs of the operations matched by an expression
+// depending on the "operation" variable alone.
+func matchOperations(expr *Expression, ops []Operation) ([]string, error) {
+	var matches []string
+	for _, op := range ops {
+		ok, err := expr.Eval(Request{Operation: op.ID}.vars())
+		if err != nil {
+			return nil, fmt.Errorf("evaluating with operation %q: %w", op.ID, err)
+		}
+		if ok {
+			matches = append(matches, op.ID)
+		}
+	}
+
+	return matches, nil
+}
+
+// dependsOnOperationOnly reports whether an expression references no other
+// variable than "operation".
+func dependsOnOperationOnly(expr *Expression) bool {
+	only := true
+	walk(expr.root, func(n node) {
+		if ident, ok := n.(*identNode); ok && ident.name != "operation" {
+			only = false
+		}
+	})
+
+	return only
+}
+
+// operationLiterals returns the string literals an expression compares the
+// "operation" variable to, with the "==", "!=" and "in" operators.
+func operationLiterals(expr *Expression) []string {
+	var literals []string
+	add := func(n node) {
+		if lit, ok := n.(*literalNode); ok {
+			if s, ok := lit.value.(string); ok {
+				literals = append(literals, s)
+			}
+		}
+	}
+	isOperation := func(n node) bool {
+		ident, ok := n.(*identNode)
+		return ok && ident.name == "operation"
+	}
+
+	walk(expr.root, func(n node) {
+		b, ok := n.(*binaryNode)
+		if !ok {
+			return
+		}
+
+		switch {
+		case (b.op == "==" || b.op == "!=") && isOperation(b.x):
+			add(b.y)
+		case (b.op == "==" || b.op == "!=") && isOperation(b.y):
+			add(b.x)
+		case b.op == "in" && isOperation(b.x):
+			if list, ok := b.y.(*listNode); ok {
+				for _, elem := range list.elems {
+					add(elem)
+				}
+			}
+		}
+	})
+
+	return literals
+}
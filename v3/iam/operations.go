@@ -0,0 +1,57 @@
+package iam
+
+import (
+	"slices"
+	"strings"
+)
+
+// Operation describes an API operation of the catalog derived from the OpenAPI
+// spec of the API.
+type Operation struct {
+	// ID is the ID of the operation, as matched by the "operation" variable of
+	// the rule expressions, e.g. "create-instance".
+	ID string
+	// Service is the policy service class of the operation, e.g. "compute".
+	Service string
+	// Method is the HTTP method of the operation.
+	Method string
+	// Path is the HTTP path template of the operation.
+	Path string
+}
+
+// Services returns the policy service classes of the API operations, sorted.
+func Services() []string {
+	var services []string
+	for _, op := range catalog {
+		if !slices.Contains(services, op.Service) {
+			services = append(services, op.Service)
+		}
+	}
+	slices.Sort(services)
+
+	return services
+}
+
+// Operations returns the API operations of a policy service class, sorted by ID.
+func Operations(service string) []Operation {
+	var ops []Operation
+	for _, op := range catalog {
+		if op.Service == service {
+			ops = append(ops, op)
+		}
+	}
+
+	return ops
+}
+
+// LookupOperation returns the API operation with an ID.
+func LookupOperation(id string) (Operation, bool) {
+	i, ok := slices.BinarySearchFunc(catalog, id, func(op Operation, id string) int {
+		return strings.Compare(op.ID, id)
+	})
+	if ok {
+		return catalog[i], true
+	}
+
+	return Operation{}, false
+}
@@ -0,0 +1,268 @@
+// Package iam provides offline tools for Exoscale IAM policies: validate the
+// rule expressions of a policy, and evaluate whether an API operation would be
+// allowed by an Organization policy and a Role policy before applying them.
+//
+//	decision, err := iam.Evaluate(orgPolicy, role.Policy, iam.Request{
+//		Service:    "compute",
+//		Operation:  "create-instance",
+//		Parameters: map[string]any{"name": "prod-web-1"},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(decision)
+package iam
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// Request describes an API operation to evaluate against IAM policies.
+type Request struct {
+	// Service is the policy service class of the operation, e.g. "compute".
+	Service string
+	// Operation is the ID of the operation, e.g. "create-instance".
+	Operation string
+	// Parameters are the parameters of the operation, available to the rule
+	// expressions as the "parameters" variable.
+	Parameters map[string]any
+	// Resources describe the resources targeted by the operation, available to
+	// the rule expressions as the "resources" variable.
+	Resources map[string]any
+	// Resource identifies the resource targeted by the operation, matched
+	// against the resources of the rules, e.g. "instance/web-1".
+	Resource string
+}
+
+func (r Request) vars() map[string]any {
+	vars := map[string]any{
+		"operation":  r.Operation,
+		"parameters": r.Parameters,
+		"resources":  r.Resources,
+	}
+	if r.Parameters == nil {
+		vars["parameters"] = map[string]any{}
+	}
+	if r.Resources == nil {
+		vars["resources"] = map[string]any{}
+	}
+
+	return vars
+}
+
+// Policy kinds reported in Decisions.
+const (
+	PolicyOrganization = "organization"
+	PolicyRole         = "role"
+)
+
+// Decision is the result of the evaluation of a Request.
+type Decision struct {
+	Allowed bool
+	// Policy is the kind of the policy which made the decision.
+	Policy string
+	// Service is the policy service evaluated.
+	Service string
+	// Rule is the index of the rule which made the decision, or -1 if it results
+	// from the service type or the default service strategy.
+	Rule int
+	// Reason describes the decision.
+	Reason string
+}
+
+// String returns a human readable description of the decision.
+func (d Decision) String() string {
+	verdict := "denied"
+	if d.Allowed {
+		verdict = "allowed"
+	}
+
+	return fmt.Sprintf("%s by %s policy: %s", verdict, d.Policy, d.Reason)
+}
+
+// Validate checks that a policy is well-formed and that its rule expressions compile.
+func Validate(policy *v3.IAMPolicy) error {
+	var errs []error
+
+	switch policy.DefaultServiceStrategy {
+	case v3.IAMPolicyDefaultServiceStrategyAllow, v3.IAMPolicyDefaultServiceStrategyDeny:
+	default:
+		errs = append(errs, fmt.Errorf("default-service-strategy: invalid value %q", policy.DefaultServiceStrategy))
+	}
+
+	for _, name := range serviceNames(policy) {
+		service := policy.Services[name]
+
+		switch service.Type {
+		case v3.IAMServicePolicyTypeAllow, v3.IAMServicePolicyTypeDeny:
+			if len(service.Rules) > 0 {
+				errs = append(errs, fmt.Errorf("services.%s: rules are only supported by the %q type", name, v3.IAMServicePolicyTypeRules))
+			}
+		case v3.IAMServicePolicyTypeRules:
+		default:
+			errs = append(errs, fmt.Errorf("services.%s.type: invalid value %q", name, service.Type))
+		}
+
+		for i, rule := range service.Rules {
+			if err := validateRule(rule); err != nil {
+				errs = append(errs, fmt.Errorf("services.%s.rules[%d]: %w", name, i, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateRule(rule v3.IAMServicePolicyRule) error {
+	switch rule.Action {
+	case v3.IAMServicePolicyRuleActionAllow, v3.IAMServicePolicyRuleActionDeny:
+	default:
+		return fmt.Errorf("invalid action %q", rule.Action)
+	}
+
+	if rule.Expression == "" {
+		return fmt.Errorf("missing expression")
+	}
+	if _, err := Compile(rule.Expression); err != nil {
+		return fmt.Errorf("expression: %w", err)
+	}
+
+	for _, pattern := range rule.Resources {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("resource %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func serviceNames(policy *v3.IAMPolicy) []string {
+	names := make([]string, 0, len(policy.Services))
+	for name := range policy.Services {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Evaluate evaluates a Request against an Organization policy and a Role
+// policy: the Request is allowed if the Organization policy doesn't deny it
+// and the Role policy allows it. A nil policy allows every Request.
+func Evaluate(org, role *v3.IAMPolicy, req Request) (Decision, error) {
+	if org != nil {
+		decision, err := EvaluateOrganization(org, req)
+		if err != nil || !decision.Allowed {
+			return decision, err
+		}
+	}
+
+	if role == nil {
+		return Decision{Allowed: true, Policy: PolicyRole, Service: req.Service, Rule: -1, Reason: "no role policy"}, nil
+	}
+
+	return EvaluateRole(role, req)
+}
+
+// EvaluateOrganization evaluates a Request against an Organization policy.
+// The Organization policy restricts the Roles: a Request not matching any rule
+// of a service of the "rules" type is allowed, leaving the decision to the Role
+// policy.
+func EvaluateOrganization(policy *v3.IAMPolicy, req Request) (Decision, error) {
+	return evaluate(policy, PolicyOrganization, req, true)
+}
+
+// EvaluateRole evaluates a Request against a Role policy.
+//
+// Services not listed in the policy follow its default service strategy. The
+// rules of a service of the "rules" type are evaluated in order, and the first
+// rule whose resources and expression match the Request decides. A Request not
+// matching any rule is denied.
+func EvaluateRole(policy *v3.IAMPolicy, req Request) (Decision, error) {
+	return evaluate(policy, PolicyRole, req, false)
+}
+
+func evaluate(policy *v3.IAMPolicy, kind string, req Request, allowUnmatched bool) (Decision, error) {
+	decision := Decision{Policy: kind, Service: req.Service, Rule: -1}
+
+	service, ok := policy.Services[req.Service]
+	if !ok {
+		switch policy.DefaultServiceStrategy {
+		case v3.IAMPolicyDefaultServiceStrategyAllow:
+			decision.Allowed = true
+		case v3.IAMPolicyDefaultServiceStrategyDeny:
+		default:
+			return decision, fmt.Errorf("default-service-strategy: invalid value %q", policy.DefaultServiceStrategy)
+		}
+		decision.Reason = fmt.Sprintf("service %q not in policy, default service strategy is %q", req.Service, policy.DefaultServiceStrategy)
+		return decision, nil
+	}
+
+	switch service.Type {
+	case v3.IAMServicePolicyTypeAllow, v3.IAMServicePolicyTypeDeny:
+		decision.Allowed = service.Type == v3.IAMServicePolicyTypeAllow
+		decision.Reason = fmt.Sprintf("service %q is of type %q", req.Service, service.Type)
+		return decision, nil
+	case v3.IAMServicePolicyTypeRules:
+	default:
+		return decision, fmt.Errorf("services.%s.type: invalid value %q", req.Service, service.Type)
+	}
+
+	vars := req.vars()
+	for i, rule := range service.Rules {
+		matched, err := matchRule(rule, req, vars)
+		if err != nil {
+			return decision, fmt.Errorf("services.%s.rules[%d]: %w", req.Service, i, err)
+		}
+		if !matched {
+			continue
+		}
+
+		decision.Allowed = rule.Action == v3.IAMServicePolicyRuleActionAllow
+		decision.Rule = i
+		decision.Reason = fmt.Sprintf("rule %d of service %q (%s %q)", i, req.Service, rule.Action, rule.Expression)
+		return decision, nil
+	}
+
+	decision.Allowed = allowUnmatched
+	decision.Reason = fmt.Sprintf("no rule of service %q matches", req.Service)
+
+	return decision, nil
+}
+
+func matchRule(rule v3.IAMServicePolicyRule, req Request, vars map[string]any) (bool, error) {
+	switch rule.Action {
+	case v3.IAMServicePolicyRuleActionAllow, v3.IAMServicePolicyRuleActionDeny:
+	default:
+		return false, fmt.Errorf("invalid action %q", rule.Action)
+	}
+
+	if len(rule.Resources) > 0 {
+		matched := false
+		for _, pattern := range rule.Resources {
+			ok, err := path.Match(pattern, req.Resource)
+			if err != nil {
+				return false, fmt.Errorf("resource %q: %w", pattern, err)
+			}
+			if ok {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+
+	expr, err := Compile(rule.Expression)
+	if err != nil {
+		return false, fmt.Errorf("expression: %w", err)
+	}
+
+	return expr.Eval(vars)
+}
@@ -0,0 +1,264 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// RollInstancePoolOpt represents a function setting a RollInstancePool option.
+type RollInstancePoolOpt func(*rollInstancePoolOptions)
+
+type rollInstancePoolOptions struct {
+	surge             int
+	maxUnavailable    int
+	pause             time.Duration
+	readyTimeout      time.Duration
+	loadBalancerID    UUID
+	serviceID         UUID
+	keepFailedMembers bool
+}
+
+// RollInstancePoolOptWithSurge returns a RollInstancePoolOpt overriding the
+// number of members created above the Instance Pool size (default: 1).
+func RollInstancePoolOptWithSurge(n int) RollInstancePoolOpt {
+	return func(o *rollInstancePoolOptions) {
+		o.surge = n
+	}
+}
+
+// RollInstancePoolOptWithMaxUnavailable returns a RollInstancePoolOpt setting
+// the number of members that can be missing below the Instance Pool size
+// (default: 0). Each batch replaces surge + max unavailable members.
+func RollInstancePoolOptWithMaxUnavailable(n int) RollInstancePoolOpt {
+	return func(o *rollInstancePoolOptions) {
+		o.maxUnavailable = n
+	}
+}
+
+// RollInstancePoolOptWithPause returns a RollInstancePoolOpt setting a pause
+// between batches, e.g. to let caches warm up.
+func RollInstancePoolOptWithPause(d time.Duration) RollInstancePoolOpt {
+	return func(o *rollInstancePoolOptions) {
+		o.pause = d
+	}
+}
+
+// RollInstancePoolOptWithReadyTimeout returns a RollInstancePoolOpt overriding
+// the maximum duration for the new members of a batch to be ready (default: 10m).
+func RollInstancePoolOptWithReadyTimeout(d time.Duration) RollInstancePoolOpt {
+	return func(o *rollInstancePoolOptions) {
+		o.readyTimeout = d
+	}
+}
+
+// RollInstancePoolOptWithLoadBalancerService returns a RollInstancePoolOpt
+// making new members ready only once they pass the healthcheck of a Network
+// Load Balancer service targeting the Instance Pool.
+func RollInstancePoolOptWithLoadBalancerService(loadBalancerID, serviceID UUID) RollInstancePoolOpt {
+	return func(o *rollInstancePoolOptions) {
+		o.loadBalancerID = loadBalancerID
+		o.serviceID = serviceID
+	}
+}
+
+// RollInstancePoolOptWithKeepFailedMembers returns a RollInstancePoolOpt keeping
+// the new members in place when the roll is aborted, e.g. to investigate them.
+func RollInstancePoolOptWithKeepFailedMembers() RollInstancePoolOpt {
+	return func(o *rollInstancePoolOptions) {
+		o.keepFailedMembers = true
+	}
+}
+
+// RollInstancePool replaces the members of an Instance Pool, e.g. to apply a new
+// template or instance type set with UpdateInstancePool, which only affects new
+// members.
+//
+// Members are replaced by batches: the Instance Pool is scaled up by the surge,
+// the new members are waited for until running, and healthy if a Load Balancer
+// service is set, then old members are evicted. The Instance Pool is scaled back
+// to its original size once all the members are replaced.
+//
+// The roll is aborted if new members aren't ready in time: old members of the
+// batch are kept, and the new members are evicted to restore the original size
+// of the Instance Pool unless the RollInstancePoolOptWithKeepFailedMembers option
+// is set.
+func (c Client) RollInstancePool(ctx context.Context, id UUID, opts ...RollInstancePoolOpt) error {
+	o := &rollInstancePoolOptions{
+		surge:        1,
+		readyTimeout: 10 * time.Minute,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.surge < 0 || o.maxUnavailable < 0 || o.surge+o.maxUnavailable == 0 {
+		return fmt.Errorf("RollInstancePool: %w: surge or max unavailable must be positive", ErrInvalidRequest)
+	}
+
+	pool, err := c.GetInstancePool(ctx, id)
+	if err != nil {
+		return fmt.Errorf("RollInstancePool: %w", err)
+	}
+	if pool.Manager != nil && pool.Manager.Type == ManagerTypeSKSNodepool {
+		return fmt.Errorf("RollInstancePool: %w: instance pool managed by SKS Nodepool %s", ErrInvalidRequest, pool.Manager.ID)
+	}
+
+	size := pool.Size
+	old := make([]UUID, 0, len(pool.Instances))
+	for _, member := range pool.Instances {
+		old = append(old, member.ID)
+	}
+	ready := make(map[UUID]bool)
+
+	for len(old) > 0 {
+		if target := size + int64(o.surge); pool.Size < target {
+			if pool, err = c.scaleInstancePool(ctx, id, target); err != nil {
+				return fmt.Errorf("RollInstancePool: %w", err)
+			}
+		}
+
+		if err := c.waitInstancePoolMembersReady(ctx, pool, old, ready, o); err != nil {
+			return fmt.Errorf("RollInstancePool: %w", c.abortRollInstancePool(ctx, id, size, old, ready, o, err))
+		}
+
+		batch := old[:min(o.surge+o.maxUnavailable, len(old))]
+		op, err := c.EvictInstancePoolMembers(ctx, id, EvictInstancePoolMembersRequest{Instances: batch})
+		if err != nil {
+			return fmt.Errorf("RollInstancePool: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return fmt.Errorf("RollInstancePool: %w", err)
+		}
+		old = old[len(batch):]
+
+		if len(old) > 0 && o.pause > 0 {
+			timer := time.NewTimer(o.pause)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("RollInstancePool: %w", ctx.Err())
+			}
+		}
+
+		if pool, err = c.GetInstancePool(ctx, id); err != nil {
+			return fmt.Errorf("RollInstancePool: %w", err)
+		}
+	}
+
+	if pool.Size != size {
+		if pool, err = c.scaleInstancePool(ctx, id, size); err != nil {
+			return fmt.Errorf("RollInstancePool: %w", err)
+		}
+	}
+	if err := c.waitInstancePoolMembersReady(ctx, pool, nil, ready, o); err != nil {
+		return fmt.Errorf("RollInstancePool: %w", c.abortRollInstancePool(ctx, id, size, nil, ready, o, err))
+	}
+
+	return nil
+}
+
+// scaleInstancePool scales an Instance Pool and returns it once scaled.
+func (c Client) scaleInstancePool(ctx context.Context, id UUID, size int64) (*InstancePool, error) {
+	op, err := c.ScaleInstancePool(ctx, id, ScaleInstancePoolRequest{Size: size})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return nil, err
+	}
+
+	return c.GetInstancePool(ctx, id)
+}
+
+// waitInstancePoolMembersReady waits for the members of an Instance Pool not
+// old nor already ready to be ready, and marks them as ready.
+func (c Client) waitInstancePoolMembersReady(ctx context.Context, pool *InstancePool, old []UUID, ready map[UUID]bool, o *rollInstancePoolOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, o.readyTimeout)
+	defer cancel()
+
+	var pending []*Instance
+	for _, member := range pool.Instances {
+		if ready[member.ID] || slices.Contains(old, member.ID) {
+			continue
+		}
+
+		instance, err := c.WaitInstanceState(ctx, member.ID, InstanceStateRunning)
+		if err != nil {
+			return fmt.Errorf("member %s not ready: %w", member.ID, err)
+		}
+		pending = append(pending, instance)
+	}
+
+	if o.loadBalancerID != "" && len(pending) > 0 {
+		err := c.poll(ctx, "RollInstancePool", c.waitOptions(), func(ctx context.Context) (string, any, bool, error) {
+			svc, err := c.GetLoadBalancerService(ctx, o.loadBalancerID, o.serviceID)
+			if err != nil {
+				return "", nil, false, err
+			}
+
+			healthy := 0
+			for _, instance := range pending {
+				if slices.ContainsFunc(svc.HealthcheckStatus, func(s LoadBalancerServerStatus) bool {
+					return s.PublicIP.Equal(instance.PublicIP) && s.Status == LoadBalancerServerStatusStatusSuccess
+				}) {
+					healthy++
+				}
+			}
+
+			return fmt.Sprintf("%d/%d healthy", healthy, len(pending)), svc, healthy == len(pending), nil
+		})
+		if err != nil {
+			return fmt.Errorf("members not healthy: %w", err)
+		}
+	}
+
+	for _, instance := range pending {
+		ready[instance.ID] = true
+	}
+
+	return nil
+}
+
+// abortRollInstancePool evicts the new members of an Instance Pool above its
+// original size, the members not ready first, and returns the error causing
+// the abort.
+func (c Client) abortRollInstancePool(ctx context.Context, id UUID, size int64, old []UUID, ready map[UUID]bool, o *rollInstancePoolOptions, cause error) error {
+	if o.keepFailedMembers {
+		return cause
+	}
+
+	pool, err := c.GetInstancePool(ctx, id)
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+
+	var failed, succeeded []UUID
+	for _, member := range pool.Instances {
+		switch {
+		case slices.Contains(old, member.ID):
+		case ready[member.ID]:
+			succeeded = append(succeeded, member.ID)
+		default:
+			failed = append(failed, member.ID)
+		}
+	}
+
+	evict := append(failed, succeeded...)
+	evict = evict[:min(max(pool.Size-size, 0), int64(len(evict)))]
+	if len(evict) == 0 {
+		return cause
+	}
+
+	op, err := c.EvictInstancePoolMembers(ctx, id, EvictInstancePoolMembersRequest{Instances: evict})
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return errors.Join(cause, err)
+	}
+
+	return cause
+}
@@ -0,0 +1,108 @@
+package v3_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+func TestRollInstancePool(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	oldTemplate := &v3.Template{ID: v3.UUID("11111111-1111-1111-1111-111111111111")}
+	newTemplate := &v3.Template{ID: v3.UUID("22222222-2222-2222-2222-222222222222")}
+
+	op, err := client.CreateInstancePool(ctx, v3.CreateInstancePoolRequest{
+		Name:         "web",
+		Size:         3,
+		DiskSize:     10,
+		InstanceType: &v3.InstanceType{ID: v3.UUID("33333333-3333-3333-3333-333333333333")},
+		Template:     oldTemplate,
+	})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	poolID := op.Reference.ID
+
+	op, err = client.CreateLoadBalancer(ctx, v3.CreateLoadBalancerRequest{Name: "web"})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	lbID := op.Reference.ID
+
+	op, err = client.AddServiceToLoadBalancer(ctx, lbID, v3.AddServiceToLoadBalancerRequest{
+		Name:         "http",
+		InstancePool: &v3.InstancePool{ID: poolID},
+		Healthcheck:  &v3.LoadBalancerServiceHealthcheck{Mode: v3.LoadBalancerServiceHealthcheckModeTCP, Port: 80},
+		Port:         80,
+		TargetPort:   80,
+		Protocol:     v3.AddServiceToLoadBalancerRequestProtocolTCP,
+		Strategy:     v3.AddServiceToLoadBalancerRequestStrategyRoundRobin,
+	})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	serviceID := op.Reference.ID
+
+	op, err = client.UpdateInstancePool(ctx, poolID, v3.UpdateInstancePoolRequest{Template: newTemplate})
+	require.NoError(t, err)
+	_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+
+	members := func() []v3.Instance {
+		pool, err := client.GetInstancePool(ctx, poolID)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), pool.Size)
+
+		instances := make([]v3.Instance, len(pool.Instances))
+		for i, member := range pool.Instances {
+			instance, err := client.GetInstance(ctx, member.ID)
+			require.NoError(t, err)
+			instances[i] = *instance
+		}
+		return instances
+	}
+
+	t.Run("Abort", func(t *testing.T) {
+		before := members()
+
+		s.SetHealthcheck(func(instance *v3.Instance) bool {
+			return instance.Template.ID != newTemplate.ID
+		})
+		defer s.SetHealthcheck(nil)
+
+		err := client.RollInstancePool(ctx, poolID,
+			v3.RollInstancePoolOptWithLoadBalancerService(lbID, serviceID),
+			v3.RollInstancePoolOptWithReadyTimeout(200*time.Millisecond),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, before, members())
+	})
+
+	err = client.RollInstancePool(ctx, poolID,
+		v3.RollInstancePoolOptWithSurge(1),
+		v3.RollInstancePoolOptWithMaxUnavailable(1),
+		v3.RollInstancePoolOptWithPause(time.Millisecond),
+		v3.RollInstancePoolOptWithLoadBalancerService(lbID, serviceID),
+	)
+	require.NoError(t, err)
+
+	for _, instance := range members() {
+		require.Equal(t, newTemplate.ID, instance.Template.ID)
+		require.Equal(t, v3.InstanceStateRunning, instance.State)
+	}
+
+	err = client.RollInstancePool(ctx, poolID, v3.RollInstancePoolOptWithSurge(0))
+	require.ErrorIs(t, err, v3.ErrInvalidRequest)
+}
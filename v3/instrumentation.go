@@ -0,0 +1,86 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Instrumentation observes the API calls and waits of a Client, e.g. to trace
+// them or record metrics. The otelegoscale module provides an OpenTelemetry
+// implementation.
+type Instrumentation interface {
+	// StartRequest is called before sending an API operation request. The returned
+	// context is used for the request, and the returned function is called once the
+	// operation completes, retries included.
+	StartRequest(ctx context.Context, info RequestInfo) (context.Context, func(RequestResult))
+
+	// StartWait is called before waiting for an async operation or a resource
+	// state. The returned function is called once the wait is over.
+	StartWait(ctx context.Context, info WaitInfo) (context.Context, func(WaitResult))
+}
+
+// RequestInfo describes an API operation request.
+type RequestInfo struct {
+	// OperationID is the API operation ID, e.g. "list-instances".
+	OperationID string
+	// Zone is the zone of the Client endpoint, empty if it is not a zone endpoint.
+	Zone ZoneName
+	// Request is the HTTP request, whose headers can be edited, e.g. to propagate
+	// a trace context.
+	Request *http.Request
+}
+
+// RequestResult describes the outcome of an API operation request.
+type RequestResult struct {
+	// StatusCode is the HTTP status code of the last response, 0 if none was received.
+	StatusCode int
+	// Retries is the number of times the request was sent again.
+	Retries int
+	// Duration is the time spent sending the request, retries included.
+	Duration time.Duration
+	// Err is the error preventing to receive a response.
+	Err error
+}
+
+// WaitInfo describes a wait.
+type WaitInfo struct {
+	// Name is the name of the waiting Client method, e.g. "WaitInstanceState".
+	Name string
+	// Zone is the zone of the Client endpoint, empty if it is not a zone endpoint.
+	Zone ZoneName
+}
+
+// WaitResult describes the outcome of a wait.
+type WaitResult struct {
+	// Polls is the number of times the resource was polled.
+	Polls int
+	// State is the last state of the resource.
+	State string
+	// Duration is the time spent waiting.
+	Duration time.Duration
+	// Err is the error ending the wait, if any.
+	Err error
+}
+
+// zone returns the zone of the Client endpoint, or an empty zone if the
+// endpoint does not follow the "https://<environment>-<zone>.exoscale.com" scheme.
+func (c Client) zone() ZoneName {
+	u, err := url.Parse(c.serverEndpoint)
+	if err != nil {
+		return ""
+	}
+
+	host, ok := strings.CutSuffix(u.Hostname(), ".exoscale.com")
+	if !ok {
+		return ""
+	}
+	_, zone, ok := strings.Cut(host, "-")
+	if !ok {
+		return ""
+	}
+
+	return ZoneName(zone)
+}
@@ -0,0 +1,192 @@
+package v3_test
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+func TestListIterator(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	for _, name := range []string{"a", "b", "c"} {
+		op, err := client.RegisterSSHKey(ctx, v3.RegisterSSHKeyRequest{Name: name, PublicKey: "ssh-ed25519 AAAA " + name})
+		require.NoError(t, err)
+		_, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+		require.NoError(t, err)
+	}
+
+	var names []string
+	for key, err := range client.AllSSHKeys(ctx) {
+		require.NoError(t, err)
+		names = append(names, key.Name)
+	}
+	require.ElementsMatch(t, []string{"a", "b", "c"}, names)
+
+	t.Run("Break", func(t *testing.T) {
+		n := 0
+		for _, err := range client.AllSSHKeys(ctx) {
+			require.NoError(t, err)
+			n++
+			break
+		}
+		require.Equal(t, 1, n)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		s.InjectFault(testserver.Fault{Path: "/ssh-key", StatusCode: http.StatusInternalServerError, Times: 1})
+
+		n := 0
+		for _, err := range client.AllSSHKeys(ctx) {
+			require.ErrorIs(t, err, v3.ErrAPIError)
+			n++
+		}
+		require.Equal(t, 1, n)
+	})
+}
+
+func TestTimeWindowIterator(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	from := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	event := func(d time.Duration) v3.Event {
+		return v3.Event{RequestID: d.String(), Timestamp: from.Add(d)}
+	}
+	s.AddEvents(
+		event(-time.Second),
+		event(0),
+		event(10*time.Hour),
+		// At the boundary of the first and second windows.
+		event(24*time.Hour),
+		event(47*time.Hour+59*time.Minute),
+		event(60*time.Hour),
+		// After the end of the range.
+		event(72*time.Hour),
+	)
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	var ids []string
+	for e, err := range client.AllEvents(ctx, from, from.Add(72*time.Hour)) {
+		require.NoError(t, err)
+		ids = append(ids, e.RequestID)
+	}
+	require.Equal(t, []string{"0s", "10h0m0s", "24h0m0s", "47h59m0s", "60h0m0s"}, ids)
+
+	t.Run("Break", func(t *testing.T) {
+		var ids []string
+		for e, err := range client.AllEvents(ctx, from, from.Add(72*time.Hour)) {
+			require.NoError(t, err)
+			if ids = append(ids, e.RequestID); len(ids) == 3 {
+				break
+			}
+		}
+		require.Equal(t, []string{"0s", "10h0m0s", "24h0m0s"}, ids)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		next, stop := iter.Pull2(client.AllEvents(ctx, from, from.Add(72*time.Hour)))
+		defer stop()
+
+		for _, want := range []string{"0s", "10h0m0s"} {
+			e, err, ok := next()
+			require.True(t, ok)
+			require.NoError(t, err)
+			require.Equal(t, want, e.RequestID)
+		}
+
+		// The request of the second window fails.
+		s.InjectFault(testserver.Fault{Path: "/event", StatusCode: http.StatusInternalServerError, Times: 1})
+
+		_, err, ok := next()
+		require.True(t, ok)
+		require.ErrorIs(t, err, v3.ErrAPIError)
+
+		_, _, ok = next()
+		require.False(t, ok)
+	})
+}
+
+func TestOffsetIterator(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	s.AddDBAASService(&v3.DBAASServicePG{Name: "pg", Plan: "hobbyist-2"})
+	for i := range 7 {
+		s.AddDBAASServiceLogs("pg", v3.DBAASServiceLogsLogs{Message: fmt.Sprintf("line %d", i)})
+	}
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	all := func(limit int64) []string {
+		var messages []string
+		for entry, err := range client.AllDBAASServiceLogs(ctx, "pg", v3.GetDBAASServiceLogsRequest{
+			Limit:     limit,
+			SortOrder: v3.EnumSortOrderAsc,
+		}) {
+			require.NoError(t, err)
+			messages = append(messages, entry.Message)
+		}
+		return messages
+	}
+
+	want := []string{"line 0", "line 1", "line 2", "line 3", "line 4", "line 5", "line 6"}
+	// Pages ending with a partial page, or exactly at the last entry.
+	require.Equal(t, want, all(3))
+	require.Equal(t, want, all(7))
+	require.Equal(t, want, all(100))
+
+	t.Run("Break", func(t *testing.T) {
+		var messages []string
+		for entry, err := range client.AllDBAASServiceLogs(ctx, "pg", v3.GetDBAASServiceLogsRequest{Limit: 3, SortOrder: v3.EnumSortOrderAsc}) {
+			require.NoError(t, err)
+			if messages = append(messages, entry.Message); len(messages) == 4 {
+				break
+			}
+		}
+		require.Equal(t, want[:4], messages)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		next, stop := iter.Pull2(client.AllDBAASServiceLogs(ctx, "pg", v3.GetDBAASServiceLogsRequest{Limit: 3, SortOrder: v3.EnumSortOrderAsc}))
+		defer stop()
+
+		for _, want := range want[:3] {
+			entry, err, ok := next()
+			require.True(t, ok)
+			require.NoError(t, err)
+			require.Equal(t, want, entry.Message)
+		}
+
+		// The request of the second page fails.
+		s.InjectFault(testserver.Fault{Path: "/dbaas-service-logs/", StatusCode: http.StatusInternalServerError, Times: 1})
+
+		_, err, ok := next()
+		require.True(t, ok)
+		require.ErrorIs(t, err, v3.ErrAPIError)
+
+		_, _, ok = next()
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,315 @@
+// Package kubeconfig provides helpers to manage the kubeconfig files of SKS
+// clusters: generate a kubeconfig with predictable entry names, merge it into
+// an existing file, and report the expiry of its client certificate.
+//
+//	cfg, err := kubeconfig.Generate(ctx, client, v3.ZoneNameCHGva2, clusterID, v3.SKSKubeconfigRequest{
+//		User:   "admin",
+//		Groups: []string{"system:masters"},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	if err := kubeconfig.MergeFile(kubeconfig.DefaultPath(), cfg); err != nil {
+//		return err
+//	}
+package kubeconfig
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// Config represents a kubeconfig file. Fields not modeled are preserved.
+type Config struct {
+	APIVersion     string         `yaml:"apiVersion,omitempty"`
+	Kind           string         `yaml:"kind,omitempty"`
+	Preferences    map[string]any `yaml:"preferences,omitempty"`
+	Clusters       []NamedCluster `yaml:"clusters"`
+	Contexts       []NamedContext `yaml:"contexts"`
+	CurrentContext string         `yaml:"current-context"`
+	Users          []NamedUser    `yaml:"users"`
+	Extra          map[string]any `yaml:",inline"`
+}
+
+// NamedCluster is a cluster entry of a kubeconfig.
+type NamedCluster struct {
+	Name    string         `yaml:"name"`
+	Cluster Cluster        `yaml:"cluster"`
+	Extra   map[string]any `yaml:",inline"`
+}
+
+// Cluster holds the information needed to reach a Kubernetes API server.
+type Cluster struct {
+	Server                   string         `yaml:"server"`
+	CertificateAuthorityData string         `yaml:"certificate-authority-data,omitempty"`
+	Extra                    map[string]any `yaml:",inline"`
+}
+
+// NamedContext is a context entry of a kubeconfig.
+type NamedContext struct {
+	Name    string         `yaml:"name"`
+	Context Context        `yaml:"context"`
+	Extra   map[string]any `yaml:",inline"`
+}
+
+// Context associates a cluster with a user.
+type Context struct {
+	Cluster   string         `yaml:"cluster"`
+	User      string         `yaml:"user"`
+	Namespace string         `yaml:"namespace,omitempty"`
+	Extra     map[string]any `yaml:",inline"`
+}
+
+// NamedUser is a user entry of a kubeconfig.
+type NamedUser struct {
+	Name  string         `yaml:"name"`
+	User  User           `yaml:"user"`
+	Extra map[string]any `yaml:",inline"`
+}
+
+// User holds the credentials of a Kubernetes user.
+type User struct {
+	ClientCertificateData string         `yaml:"client-certificate-data,omitempty"`
+	ClientKeyData         string         `yaml:"client-key-data,omitempty"`
+	Extra                 map[string]any `yaml:",inline"`
+}
+
+// Name returns the name given to the entries of the kubeconfig of an SKS cluster.
+func Name(zone v3.ZoneName, clusterName string) string {
+	return string(zone) + "-" + clusterName
+}
+
+// Parse parses a kubeconfig.
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parse kubeconfig: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// Marshal returns the YAML encoding of the kubeconfig.
+func (c *Config) Marshal() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// Generate generates a kubeconfig for an SKS cluster of the given zone, which
+// the client must target, and renames its cluster, context and user entries to
+// "<zone>-<cluster name>".
+func Generate(ctx context.Context, client v3.API, zone v3.ZoneName, clusterID v3.UUID, req v3.SKSKubeconfigRequest) (*Config, error) {
+	cluster, err := client.GetSKSCluster(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("get SKS cluster: %w", err)
+	}
+
+	resp, err := client.GenerateSKSClusterKubeconfig(ctx, clusterID, req)
+	if err != nil {
+		return nil, fmt.Errorf("generate kubeconfig: %w", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(resp.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("decode kubeconfig: %w", err)
+	}
+
+	cfg, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Rename(Name(zone, cluster.Name)); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Rename renames the cluster, context and user entries of a kubeconfig holding
+// a single one of each, updating the references to them.
+func (c *Config) Rename(name string) error {
+	if len(c.Clusters) != 1 || len(c.Contexts) != 1 || len(c.Users) != 1 {
+		return fmt.Errorf("rename kubeconfig: expected a single cluster, context and user, got %d, %d and %d",
+			len(c.Clusters), len(c.Contexts), len(c.Users))
+	}
+
+	c.Clusters[0].Name = name
+	c.Users[0].Name = name
+	c.Contexts[0].Name = name
+	c.Contexts[0].Context.Cluster = name
+	c.Contexts[0].Context.User = name
+	c.CurrentContext = name
+
+	return nil
+}
+
+// Merge merges the entries of another kubeconfig into this one: entries with the
+// same name are replaced, the others are appended. The current context is only
+// set if empty.
+func (c *Config) Merge(other *Config) {
+	for _, cluster := range other.Clusters {
+		c.Clusters = mergeEntry(c.Clusters, cluster, func(e NamedCluster) string { return e.Name })
+	}
+	for _, ctx := range other.Contexts {
+		c.Contexts = mergeEntry(c.Contexts, ctx, func(e NamedContext) string { return e.Name })
+	}
+	for _, user := range other.Users {
+		c.Users = mergeEntry(c.Users, user, func(e NamedUser) string { return e.Name })
+	}
+
+	if c.APIVersion == "" {
+		c.APIVersion = other.APIVersion
+	}
+	if c.Kind == "" {
+		c.Kind = other.Kind
+	}
+	if c.CurrentContext == "" {
+		c.CurrentContext = other.CurrentContext
+	}
+}
+
+func mergeEntry[T any](entries []T, entry T, name func(T) string) []T {
+	for i, e := range entries {
+		if name(e) == name(entry) {
+			entries[i] = entry
+			return entries
+		}
+	}
+
+	return append(entries, entry)
+}
+
+// CertificateExpiry returns the expiry date of the client certificate of the
+// user of a context, or of the current context if empty.
+func (c *Config) CertificateExpiry(contextName string) (time.Time, error) {
+	if contextName == "" {
+		contextName = c.CurrentContext
+	}
+
+	var user string
+	for _, e := range c.Contexts {
+		if e.Name == contextName {
+			user = e.Context.User
+			break
+		}
+	}
+	if user == "" {
+		return time.Time{}, fmt.Errorf("context %q not found", contextName)
+	}
+
+	for _, e := range c.Users {
+		if e.Name != user {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(e.User.ClientCertificateData)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("user %q: decode client certificate: %w", user, err)
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return time.Time{}, fmt.Errorf("user %q: no client certificate", user)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("user %q: parse client certificate: %w", user, err)
+		}
+
+		return cert.NotAfter, nil
+	}
+
+	return time.Time{}, fmt.Errorf("user %q not found", user)
+}
+
+// DefaultPath returns the path of the kubeconfig file used by kubectl: the first
+// path of the KUBECONFIG environment variable if set, ~/.kube/config otherwise.
+func DefaultPath() string {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) > 0 && paths[0] != "" {
+		return paths[0]
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".kube", "config")
+	}
+
+	return filepath.Join(home, ".kube", "config")
+}
+
+// MergeFileOpt represents a function setting a MergeFile option.
+type MergeFileOpt func(*mergeFileOptions)
+
+type mergeFileOptions struct {
+	useContext bool
+}
+
+// MergeFileOptWithCurrentContext returns a MergeFileOpt switching the current
+// context of the file to the one of the merged kubeconfig.
+func MergeFileOptWithCurrentContext() MergeFileOpt {
+	return func(o *mergeFileOptions) {
+		o.useContext = true
+	}
+}
+
+// MergeFile merges a kubeconfig into a file, created if missing, leaving its
+// other entries untouched. The file is replaced atomically.
+func MergeFile(path string, cfg *Config, opts ...MergeFileOpt) error {
+	o := &mergeFileOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	existing := &Config{}
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("read kubeconfig: %w", err)
+	default:
+		if existing, err = Parse(data); err != nil {
+			return err
+		}
+	}
+
+	existing.Merge(cfg)
+	if o.useContext {
+		existing.CurrentContext = cfg.CurrentContext
+	}
+
+	if data, err = existing.Marshal(); err != nil {
+		return fmt.Errorf("marshal kubeconfig: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("write kubeconfig: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("write kubeconfig: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("write kubeconfig: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write kubeconfig: %w", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("write kubeconfig: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package kubeconfig
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.net
+    insecure-skip-tls-verify: true
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+current-context: other
+users:
+- name: other
+  user:
+    token: secret
+`
+
+func TestGenerateAndMergeFile(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	op, err := client.CreateSKSCluster(ctx, v3.CreateSKSClusterRequest{Name: "prod", Version: "1.31.0"})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+
+	cfg, err := Generate(ctx, client, v3.ZoneNameCHGva2, op.Reference.ID, v3.SKSKubeconfigRequest{
+		User: "admin",
+		Ttl:  int64((24 * time.Hour).Seconds()),
+	})
+	require.NoError(t, err)
+
+	name := "ch-gva-2-prod"
+	require.Equal(t, name, cfg.CurrentContext)
+	require.Equal(t, name, cfg.Clusters[0].Name)
+	require.Equal(t, name, cfg.Users[0].Name)
+	require.Equal(t, Context{Cluster: name, User: name}, cfg.Contexts[0].Context)
+
+	expiry, err := cfg.CertificateExpiry("")
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), expiry, time.Minute)
+
+	path := filepath.Join(t.TempDir(), ".kube", "config")
+	require.NoError(t, MergeFile(path, cfg))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	require.NoError(t, os.WriteFile(path, []byte(testKubeconfig), 0o600))
+	require.NoError(t, MergeFile(path, cfg))
+	require.NoError(t, MergeFile(path, cfg, MergeFileOptWithCurrentContext()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	merged, err := Parse(data)
+	require.NoError(t, err)
+
+	require.Equal(t, name, merged.CurrentContext)
+	require.Len(t, merged.Clusters, 2)
+	require.Len(t, merged.Contexts, 2)
+	require.Len(t, merged.Users, 2)
+	require.Equal(t, map[string]any{"insecure-skip-tls-verify": true}, merged.Clusters[0].Cluster.Extra)
+	require.Equal(t, map[string]any{"token": "secret"}, merged.Users[0].User.Extra)
+
+	_, err = merged.CertificateExpiry("other")
+	require.Error(t, err)
+	_, err = merged.CertificateExpiry(name)
+	require.NoError(t, err)
+}
@@ -0,0 +1,193 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveHeaders are the HTTP headers redacted from logs and traces.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are the JSON fields redacted from logged bodies, in addition to
+// the fields holding URIs which can embed credentials. The v2 API client
+// redacts the same fields (v2/api/middleware.go), kept in sync by the
+// TestSensitiveFieldsInSync test of the v2/api package.
+var sensitiveFields = map[string]bool{
+	"password":       true,
+	"admin-password": true,
+	"secret":         true,
+	// Private key of the TLS client certificate of DBaaS Kafka users.
+	"access-key": true,
+	"kubeconfig": true,
+	"user-data":  true,
+	"uri-params": true,
+}
+
+// LoggerOpt represents a function setting a request logging option.
+type LoggerOpt func(*requestLogger)
+
+// LoggerOptWithLevel returns a LoggerOpt overriding the level of the successful
+// requests logs, debug by default.
+func LoggerOptWithLevel(level slog.Level) LoggerOpt {
+	return func(l *requestLogger) {
+		l.level = level
+	}
+}
+
+// LoggerOptWithErrorLevel returns a LoggerOpt overriding the level of the failed
+// requests logs, warning by default.
+func LoggerOptWithErrorLevel(level slog.Level) LoggerOpt {
+	return func(l *requestLogger) {
+		l.errorLevel = level
+	}
+}
+
+// LoggerOptWithBodies returns a LoggerOpt also logging the JSON request and
+// response bodies. Sensitive fields such as passwords, secrets, kubeconfigs,
+// user-data and URIs are redacted.
+func LoggerOptWithBodies() LoggerOpt {
+	return func(l *requestLogger) {
+		l.bodies = true
+	}
+}
+
+// requestLogger logs the API requests of a Client.
+type requestLogger struct {
+	logger     *slog.Logger
+	level      slog.Level
+	errorLevel slog.Level
+	bodies     bool
+}
+
+func newRequestLogger(logger *slog.Logger, opts ...LoggerOpt) *requestLogger {
+	l := &requestLogger{
+		logger:     logger,
+		level:      slog.LevelDebug,
+		errorLevel: slog.LevelWarn,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// log logs an API operation request and its outcome.
+func (l *requestLogger) log(ctx context.Context, req *http.Request, resp *http.Response, operationID string, result RequestResult) {
+	level := l.level
+	if result.Err != nil || result.StatusCode >= http.StatusBadRequest {
+		level = l.errorLevel
+	}
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.Duration("duration", result.Duration),
+	}
+	if result.StatusCode != 0 {
+		attrs = append(attrs, slog.Int("status", result.StatusCode))
+	}
+	if result.Retries > 0 {
+		attrs = append(attrs, slog.Int("retries", result.Retries))
+	}
+	if result.Err != nil {
+		attrs = append(attrs, slog.String("error", result.Err.Error()))
+	}
+	if requestID := headerValue(resp, "X-Request-Id"); requestID != "" {
+		attrs = append(attrs, slog.String("request_id", requestID))
+	}
+
+	if l.bodies {
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				data, _ := io.ReadAll(body)
+				body.Close()
+				attrs = append(attrs, slog.String("request_body", redactBody(data)))
+			}
+		}
+		if resp != nil {
+			data, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(data))
+			if err == nil {
+				attrs = append(attrs, slog.String("response_body", redactBody(data)))
+			}
+		}
+	}
+
+	l.logger.LogAttrs(ctx, level, "API request", attrs...)
+}
+
+func headerValue(resp *http.Response, key string) string {
+	if resp == nil {
+		return ""
+	}
+
+	return resp.Header.Get(key)
+}
+
+// redactHeader returns a copy of an HTTP header with the sensitive values redacted.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+
+	return h
+}
+
+// redactBody returns a JSON body with the sensitive fields redacted.
+// Non-JSON bodies are omitted, since they cannot be redacted.
+func redactBody(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return "<body omitted>"
+	}
+
+	return string(out)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if isSensitiveField(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(val)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+
+	return v
+}
+
+func isSensitiveField(name string) bool {
+	return sensitiveFields[name] || name == "uri" || strings.HasSuffix(name, "-uri")
+}
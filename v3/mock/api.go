@@ -0,0 +1,2546 @@
+// Package mock provides a mock implementation of the API interface.
+//
+// Code generated by github.com/egoscale/v3/generator version v0.0.1 DO NOT EDIT.
+package mock
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/sauterp/egoscale/v3"
+	testifymock "github.com/stretchr/testify/mock"
+)
+
+// API is a mock implementation of v3.API.
+type API struct {
+	testifymock.Mock
+}
+
+var _ v3.API = (*API)(nil)
+
+// NewAPI returns an API mock asserting its expectations when the test ends.
+func NewAPI(t interface {
+	testifymock.TestingT
+	Cleanup(func())
+}) *API {
+	m := &API{}
+	m.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+
+	return m
+}
+
+// AddExternalSourceToSecurityGroup mocks v3.Client.AddExternalSourceToSecurityGroup.
+func (m *API) AddExternalSourceToSecurityGroup(ctx context.Context, id v3.UUID, req v3.AddExternalSourceToSecurityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AddInstanceProtection mocks v3.Client.AddInstanceProtection.
+func (m *API) AddInstanceProtection(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AddRuleToSecurityGroup mocks v3.Client.AddRuleToSecurityGroup.
+func (m *API) AddRuleToSecurityGroup(ctx context.Context, id v3.UUID, req v3.AddRuleToSecurityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AddServiceToLoadBalancer mocks v3.Client.AddServiceToLoadBalancer.
+func (m *API) AddServiceToLoadBalancer(ctx context.Context, id v3.UUID, req v3.AddServiceToLoadBalancerRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AllAPIKeys mocks v3.Client.AllAPIKeys.
+func (m *API) AllAPIKeys(ctx context.Context) iter.Seq2[v3.IAMAPIKey, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.IAMAPIKey, error])
+
+	return r0
+}
+
+// AllAntiAffinityGroups mocks v3.Client.AllAntiAffinityGroups.
+func (m *API) AllAntiAffinityGroups(ctx context.Context) iter.Seq2[v3.AntiAffinityGroup, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.AntiAffinityGroup, error])
+
+	return r0
+}
+
+// AllBlockStorageSnapshots mocks v3.Client.AllBlockStorageSnapshots.
+func (m *API) AllBlockStorageSnapshots(ctx context.Context) iter.Seq2[v3.BlockStorageSnapshot, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.BlockStorageSnapshot, error])
+
+	return r0
+}
+
+// AllBlockStorageVolumes mocks v3.Client.AllBlockStorageVolumes.
+func (m *API) AllBlockStorageVolumes(ctx context.Context, opts ...v3.ListBlockStorageVolumesOpt) iter.Seq2[v3.BlockStorageVolume, error] {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(iter.Seq2[v3.BlockStorageVolume, error])
+
+	return r0
+}
+
+// AllDBAASIntegrationTypes mocks v3.Client.AllDBAASIntegrationTypes.
+func (m *API) AllDBAASIntegrationTypes(ctx context.Context) iter.Seq2[v3.DBAASIntegrationType, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DBAASIntegrationType, error])
+
+	return r0
+}
+
+// AllDBAASServiceLogs mocks v3.Client.AllDBAASServiceLogs.
+func (m *API) AllDBAASServiceLogs(ctx context.Context, serviceName string, req v3.GetDBAASServiceLogsRequest) iter.Seq2[v3.DBAASServiceLogsLogs, error] {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DBAASServiceLogsLogs, error])
+
+	return r0
+}
+
+// AllDBAASServiceTypes mocks v3.Client.AllDBAASServiceTypes.
+func (m *API) AllDBAASServiceTypes(ctx context.Context) iter.Seq2[v3.DBAASServiceType, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DBAASServiceType, error])
+
+	return r0
+}
+
+// AllDBAASServices mocks v3.Client.AllDBAASServices.
+func (m *API) AllDBAASServices(ctx context.Context) iter.Seq2[v3.DBAASServiceCommon, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DBAASServiceCommon, error])
+
+	return r0
+}
+
+// AllDNSDomainRecords mocks v3.Client.AllDNSDomainRecords.
+func (m *API) AllDNSDomainRecords(ctx context.Context, domainID v3.UUID) iter.Seq2[v3.DNSDomainRecord, error] {
+	args := m.Called(ctx, domainID)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DNSDomainRecord, error])
+
+	return r0
+}
+
+// AllDNSDomains mocks v3.Client.AllDNSDomains.
+func (m *API) AllDNSDomains(ctx context.Context) iter.Seq2[v3.DNSDomain, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DNSDomain, error])
+
+	return r0
+}
+
+// AllDeployTargets mocks v3.Client.AllDeployTargets.
+func (m *API) AllDeployTargets(ctx context.Context) iter.Seq2[v3.DeployTarget, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DeployTarget, error])
+
+	return r0
+}
+
+// AllElasticIPS mocks v3.Client.AllElasticIPS.
+func (m *API) AllElasticIPS(ctx context.Context) iter.Seq2[v3.ElasticIP, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.ElasticIP, error])
+
+	return r0
+}
+
+// AllEvents mocks v3.Client.AllEvents.
+func (m *API) AllEvents(ctx context.Context, from time.Time, to time.Time) iter.Seq2[v3.Event, error] {
+	args := m.Called(ctx, from, to)
+	r0, _ := args.Get(0).(iter.Seq2[v3.Event, error])
+
+	return r0
+}
+
+// AllIAMRoles mocks v3.Client.AllIAMRoles.
+func (m *API) AllIAMRoles(ctx context.Context) iter.Seq2[v3.IAMRole, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.IAMRole, error])
+
+	return r0
+}
+
+// AllInstancePools mocks v3.Client.AllInstancePools.
+func (m *API) AllInstancePools(ctx context.Context) iter.Seq2[v3.InstancePool, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.InstancePool, error])
+
+	return r0
+}
+
+// AllInstanceTypes mocks v3.Client.AllInstanceTypes.
+func (m *API) AllInstanceTypes(ctx context.Context) iter.Seq2[v3.InstanceType, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.InstanceType, error])
+
+	return r0
+}
+
+// AllInstances mocks v3.Client.AllInstances.
+func (m *API) AllInstances(ctx context.Context, opts ...v3.ListInstancesOpt) iter.Seq2[v3.ListInstancesResponseInstances, error] {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(iter.Seq2[v3.ListInstancesResponseInstances, error])
+
+	return r0
+}
+
+// AllLoadBalancers mocks v3.Client.AllLoadBalancers.
+func (m *API) AllLoadBalancers(ctx context.Context) iter.Seq2[v3.LoadBalancer, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.LoadBalancer, error])
+
+	return r0
+}
+
+// AllPrivateNetworks mocks v3.Client.AllPrivateNetworks.
+func (m *API) AllPrivateNetworks(ctx context.Context) iter.Seq2[v3.PrivateNetwork, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.PrivateNetwork, error])
+
+	return r0
+}
+
+// AllQuotas mocks v3.Client.AllQuotas.
+func (m *API) AllQuotas(ctx context.Context) iter.Seq2[v3.Quota, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.Quota, error])
+
+	return r0
+}
+
+// AllSKSClusterDeprecatedResources mocks v3.Client.AllSKSClusterDeprecatedResources.
+func (m *API) AllSKSClusterDeprecatedResources(ctx context.Context, id v3.UUID) iter.Seq2[v3.SKSClusterDeprecatedResource, error] {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(iter.Seq2[v3.SKSClusterDeprecatedResource, error])
+
+	return r0
+}
+
+// AllSKSClusters mocks v3.Client.AllSKSClusters.
+func (m *API) AllSKSClusters(ctx context.Context) iter.Seq2[v3.SKSCluster, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.SKSCluster, error])
+
+	return r0
+}
+
+// AllSOSBucketsUsage mocks v3.Client.AllSOSBucketsUsage.
+func (m *API) AllSOSBucketsUsage(ctx context.Context) iter.Seq2[v3.SOSBucketUsage, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.SOSBucketUsage, error])
+
+	return r0
+}
+
+// AllSSHKeys mocks v3.Client.AllSSHKeys.
+func (m *API) AllSSHKeys(ctx context.Context) iter.Seq2[v3.SSHKey, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.SSHKey, error])
+
+	return r0
+}
+
+// AllSecurityGroups mocks v3.Client.AllSecurityGroups.
+func (m *API) AllSecurityGroups(ctx context.Context, opts ...v3.ListSecurityGroupsOpt) iter.Seq2[v3.SecurityGroup, error] {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(iter.Seq2[v3.SecurityGroup, error])
+
+	return r0
+}
+
+// AllSnapshots mocks v3.Client.AllSnapshots.
+func (m *API) AllSnapshots(ctx context.Context) iter.Seq2[v3.Snapshot, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.Snapshot, error])
+
+	return r0
+}
+
+// AllTemplates mocks v3.Client.AllTemplates.
+func (m *API) AllTemplates(ctx context.Context, opts ...v3.ListTemplatesOpt) iter.Seq2[v3.Template, error] {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(iter.Seq2[v3.Template, error])
+
+	return r0
+}
+
+// AllZones mocks v3.Client.AllZones.
+func (m *API) AllZones(ctx context.Context) iter.Seq2[v3.Zone, error] {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(iter.Seq2[v3.Zone, error])
+
+	return r0
+}
+
+// AttachBlockStorageVolumeToInstance mocks v3.Client.AttachBlockStorageVolumeToInstance.
+func (m *API) AttachBlockStorageVolumeToInstance(ctx context.Context, id v3.UUID, req v3.AttachBlockStorageVolumeToInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AttachInstanceToElasticIP mocks v3.Client.AttachInstanceToElasticIP.
+func (m *API) AttachInstanceToElasticIP(ctx context.Context, id v3.UUID, req v3.AttachInstanceToElasticIPRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AttachInstanceToPrivateNetwork mocks v3.Client.AttachInstanceToPrivateNetwork.
+func (m *API) AttachInstanceToPrivateNetwork(ctx context.Context, id v3.UUID, req v3.AttachInstanceToPrivateNetworkRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// AttachInstanceToSecurityGroup mocks v3.Client.AttachInstanceToSecurityGroup.
+func (m *API) AttachInstanceToSecurityGroup(ctx context.Context, id v3.UUID, req v3.AttachInstanceToSecurityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CopyTemplate mocks v3.Client.CopyTemplate.
+func (m *API) CopyTemplate(ctx context.Context, id v3.UUID, req v3.CopyTemplateRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateAPIKey mocks v3.Client.CreateAPIKey.
+func (m *API) CreateAPIKey(ctx context.Context, req v3.CreateAPIKeyRequest) (*v3.IAMAPIKeyCreated, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.IAMAPIKeyCreated)
+
+	return r0, args.Error(1)
+}
+
+// CreateAntiAffinityGroup mocks v3.Client.CreateAntiAffinityGroup.
+func (m *API) CreateAntiAffinityGroup(ctx context.Context, req v3.CreateAntiAffinityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateBlockStorageSnapshot mocks v3.Client.CreateBlockStorageSnapshot.
+func (m *API) CreateBlockStorageSnapshot(ctx context.Context, id v3.UUID, req v3.CreateBlockStorageSnapshotRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateBlockStorageVolume mocks v3.Client.CreateBlockStorageVolume.
+func (m *API) CreateBlockStorageVolume(ctx context.Context, req v3.CreateBlockStorageVolumeRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASIntegration mocks v3.Client.CreateDBAASIntegration.
+func (m *API) CreateDBAASIntegration(ctx context.Context, req v3.CreateDBAASIntegrationRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASKafkaSchemaRegistryAclConfig mocks v3.Client.CreateDBAASKafkaSchemaRegistryAclConfig.
+func (m *API) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, req v3.DBAASKafkaSchemaRegistryAclEntry) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASKafkaTopicAclConfig mocks v3.Client.CreateDBAASKafkaTopicAclConfig.
+func (m *API) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string, req v3.DBAASKafkaTopicAclEntry) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASKafkaUser mocks v3.Client.CreateDBAASKafkaUser.
+func (m *API) CreateDBAASKafkaUser(ctx context.Context, serviceName string, req v3.CreateDBAASKafkaUserRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASMysqlDatabase mocks v3.Client.CreateDBAASMysqlDatabase.
+func (m *API) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string, req v3.CreateDBAASMysqlDatabaseRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASMysqlUser mocks v3.Client.CreateDBAASMysqlUser.
+func (m *API) CreateDBAASMysqlUser(ctx context.Context, serviceName string, req v3.CreateDBAASMysqlUserRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASOpensearchUser mocks v3.Client.CreateDBAASOpensearchUser.
+func (m *API) CreateDBAASOpensearchUser(ctx context.Context, serviceName string, req v3.CreateDBAASOpensearchUserRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASPGConnectionPool mocks v3.Client.CreateDBAASPGConnectionPool.
+func (m *API) CreateDBAASPGConnectionPool(ctx context.Context, serviceName string, req v3.CreateDBAASPGConnectionPoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASPGDatabase mocks v3.Client.CreateDBAASPGDatabase.
+func (m *API) CreateDBAASPGDatabase(ctx context.Context, serviceName string, req v3.CreateDBAASPGDatabaseRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASPGUpgradeCheck mocks v3.Client.CreateDBAASPGUpgradeCheck.
+func (m *API) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, req v3.CreateDBAASPGUpgradeCheckRequest) (*v3.DBAASTask, error) {
+	args := m.Called(ctx, service, req)
+	r0, _ := args.Get(0).(*v3.DBAASTask)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASPostgresUser mocks v3.Client.CreateDBAASPostgresUser.
+func (m *API) CreateDBAASPostgresUser(ctx context.Context, serviceName string, req v3.CreateDBAASPostgresUserRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASRedisUser mocks v3.Client.CreateDBAASRedisUser.
+func (m *API) CreateDBAASRedisUser(ctx context.Context, serviceName string, req v3.CreateDBAASRedisUserRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASServiceGrafana mocks v3.Client.CreateDBAASServiceGrafana.
+func (m *API) CreateDBAASServiceGrafana(ctx context.Context, name string, req v3.CreateDBAASServiceGrafanaRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASServiceKafka mocks v3.Client.CreateDBAASServiceKafka.
+func (m *API) CreateDBAASServiceKafka(ctx context.Context, name string, req v3.CreateDBAASServiceKafkaRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASServiceMysql mocks v3.Client.CreateDBAASServiceMysql.
+func (m *API) CreateDBAASServiceMysql(ctx context.Context, name string, req v3.CreateDBAASServiceMysqlRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASServiceOpensearch mocks v3.Client.CreateDBAASServiceOpensearch.
+func (m *API) CreateDBAASServiceOpensearch(ctx context.Context, name string, req v3.CreateDBAASServiceOpensearchRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASServicePG mocks v3.Client.CreateDBAASServicePG.
+func (m *API) CreateDBAASServicePG(ctx context.Context, name string, req v3.CreateDBAASServicePGRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASServiceRedis mocks v3.Client.CreateDBAASServiceRedis.
+func (m *API) CreateDBAASServiceRedis(ctx context.Context, name string, req v3.CreateDBAASServiceRedisRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDBAASTaskMigrationCheck mocks v3.Client.CreateDBAASTaskMigrationCheck.
+func (m *API) CreateDBAASTaskMigrationCheck(ctx context.Context, service string, req v3.CreateDBAASTaskMigrationCheckRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, service, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateDNSDomain mocks v3.Client.CreateDNSDomain.
+func (m *API) CreateDNSDomain(ctx context.Context, req v3.CreateDNSDomainRequest) (*v3.DNSDomain, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.DNSDomain)
+
+	return r0, args.Error(1)
+}
+
+// CreateDNSDomainRecord mocks v3.Client.CreateDNSDomainRecord.
+func (m *API) CreateDNSDomainRecord(ctx context.Context, domainID v3.UUID, req v3.CreateDNSDomainRecordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, domainID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateElasticIP mocks v3.Client.CreateElasticIP.
+func (m *API) CreateElasticIP(ctx context.Context, req v3.CreateElasticIPRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateIAMRole mocks v3.Client.CreateIAMRole.
+func (m *API) CreateIAMRole(ctx context.Context, req v3.CreateIAMRoleRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateInstance mocks v3.Client.CreateInstance.
+func (m *API) CreateInstance(ctx context.Context, req v3.CreateInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateInstancePool mocks v3.Client.CreateInstancePool.
+func (m *API) CreateInstancePool(ctx context.Context, req v3.CreateInstancePoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateLoadBalancer mocks v3.Client.CreateLoadBalancer.
+func (m *API) CreateLoadBalancer(ctx context.Context, req v3.CreateLoadBalancerRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreatePrivateNetwork mocks v3.Client.CreatePrivateNetwork.
+func (m *API) CreatePrivateNetwork(ctx context.Context, req v3.CreatePrivateNetworkRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateSKSCluster mocks v3.Client.CreateSKSCluster.
+func (m *API) CreateSKSCluster(ctx context.Context, req v3.CreateSKSClusterRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateSKSNodepool mocks v3.Client.CreateSKSNodepool.
+func (m *API) CreateSKSNodepool(ctx context.Context, id v3.UUID, req v3.CreateSKSNodepoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateSecurityGroup mocks v3.Client.CreateSecurityGroup.
+func (m *API) CreateSecurityGroup(ctx context.Context, req v3.CreateSecurityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// CreateSnapshot mocks v3.Client.CreateSnapshot.
+func (m *API) CreateSnapshot(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteAPIKey mocks v3.Client.DeleteAPIKey.
+func (m *API) DeleteAPIKey(ctx context.Context, id string) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteAntiAffinityGroup mocks v3.Client.DeleteAntiAffinityGroup.
+func (m *API) DeleteAntiAffinityGroup(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteBlockStorageSnapshot mocks v3.Client.DeleteBlockStorageSnapshot.
+func (m *API) DeleteBlockStorageSnapshot(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteBlockStorageVolume mocks v3.Client.DeleteBlockStorageVolume.
+func (m *API) DeleteBlockStorageVolume(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASIntegration mocks v3.Client.DeleteDBAASIntegration.
+func (m *API) DeleteDBAASIntegration(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASKafkaSchemaRegistryAclConfig mocks v3.Client.DeleteDBAASKafkaSchemaRegistryAclConfig.
+func (m *API) DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, aclID string) (*v3.Operation, error) {
+	args := m.Called(ctx, name, aclID)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASKafkaTopicAclConfig mocks v3.Client.DeleteDBAASKafkaTopicAclConfig.
+func (m *API) DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string, aclID string) (*v3.Operation, error) {
+	args := m.Called(ctx, name, aclID)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASKafkaUser mocks v3.Client.DeleteDBAASKafkaUser.
+func (m *API) DeleteDBAASKafkaUser(ctx context.Context, serviceName string, username string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASMysqlDatabase mocks v3.Client.DeleteDBAASMysqlDatabase.
+func (m *API) DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string, databaseName string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, databaseName)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASMysqlUser mocks v3.Client.DeleteDBAASMysqlUser.
+func (m *API) DeleteDBAASMysqlUser(ctx context.Context, serviceName string, username string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASOpensearchUser mocks v3.Client.DeleteDBAASOpensearchUser.
+func (m *API) DeleteDBAASOpensearchUser(ctx context.Context, serviceName string, username string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASPGConnectionPool mocks v3.Client.DeleteDBAASPGConnectionPool.
+func (m *API) DeleteDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, connectionPoolName)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASPGDatabase mocks v3.Client.DeleteDBAASPGDatabase.
+func (m *API) DeleteDBAASPGDatabase(ctx context.Context, serviceName string, databaseName string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, databaseName)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASPostgresUser mocks v3.Client.DeleteDBAASPostgresUser.
+func (m *API) DeleteDBAASPostgresUser(ctx context.Context, serviceName string, username string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASRedisUser mocks v3.Client.DeleteDBAASRedisUser.
+func (m *API) DeleteDBAASRedisUser(ctx context.Context, serviceName string, username string) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASService mocks v3.Client.DeleteDBAASService.
+func (m *API) DeleteDBAASService(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASServiceGrafana mocks v3.Client.DeleteDBAASServiceGrafana.
+func (m *API) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASServiceKafka mocks v3.Client.DeleteDBAASServiceKafka.
+func (m *API) DeleteDBAASServiceKafka(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASServiceMysql mocks v3.Client.DeleteDBAASServiceMysql.
+func (m *API) DeleteDBAASServiceMysql(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASServiceOpensearch mocks v3.Client.DeleteDBAASServiceOpensearch.
+func (m *API) DeleteDBAASServiceOpensearch(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASServicePG mocks v3.Client.DeleteDBAASServicePG.
+func (m *API) DeleteDBAASServicePG(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDBAASServiceRedis mocks v3.Client.DeleteDBAASServiceRedis.
+func (m *API) DeleteDBAASServiceRedis(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDNSDomain mocks v3.Client.DeleteDNSDomain.
+func (m *API) DeleteDNSDomain(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteDNSDomainRecord mocks v3.Client.DeleteDNSDomainRecord.
+func (m *API) DeleteDNSDomainRecord(ctx context.Context, domainID v3.UUID, recordID v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, domainID, recordID)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteElasticIP mocks v3.Client.DeleteElasticIP.
+func (m *API) DeleteElasticIP(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteIAMRole mocks v3.Client.DeleteIAMRole.
+func (m *API) DeleteIAMRole(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteInstance mocks v3.Client.DeleteInstance.
+func (m *API) DeleteInstance(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteInstancePool mocks v3.Client.DeleteInstancePool.
+func (m *API) DeleteInstancePool(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteLoadBalancer mocks v3.Client.DeleteLoadBalancer.
+func (m *API) DeleteLoadBalancer(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteLoadBalancerService mocks v3.Client.DeleteLoadBalancerService.
+func (m *API) DeleteLoadBalancerService(ctx context.Context, id v3.UUID, serviceID v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id, serviceID)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeletePrivateNetwork mocks v3.Client.DeletePrivateNetwork.
+func (m *API) DeletePrivateNetwork(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteReverseDNSElasticIP mocks v3.Client.DeleteReverseDNSElasticIP.
+func (m *API) DeleteReverseDNSElasticIP(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteReverseDNSInstance mocks v3.Client.DeleteReverseDNSInstance.
+func (m *API) DeleteReverseDNSInstance(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteRuleFromSecurityGroup mocks v3.Client.DeleteRuleFromSecurityGroup.
+func (m *API) DeleteRuleFromSecurityGroup(ctx context.Context, id v3.UUID, ruleID v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id, ruleID)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteSKSCluster mocks v3.Client.DeleteSKSCluster.
+func (m *API) DeleteSKSCluster(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteSKSNodepool mocks v3.Client.DeleteSKSNodepool.
+func (m *API) DeleteSKSNodepool(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id, sksNodepoolID)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteSSHKey mocks v3.Client.DeleteSSHKey.
+func (m *API) DeleteSSHKey(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteSecurityGroup mocks v3.Client.DeleteSecurityGroup.
+func (m *API) DeleteSecurityGroup(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteSnapshot mocks v3.Client.DeleteSnapshot.
+func (m *API) DeleteSnapshot(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DeleteTemplate mocks v3.Client.DeleteTemplate.
+func (m *API) DeleteTemplate(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DetachBlockStorageVolume mocks v3.Client.DetachBlockStorageVolume.
+func (m *API) DetachBlockStorageVolume(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DetachInstanceFromElasticIP mocks v3.Client.DetachInstanceFromElasticIP.
+func (m *API) DetachInstanceFromElasticIP(ctx context.Context, id v3.UUID, req v3.DetachInstanceFromElasticIPRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DetachInstanceFromPrivateNetwork mocks v3.Client.DetachInstanceFromPrivateNetwork.
+func (m *API) DetachInstanceFromPrivateNetwork(ctx context.Context, id v3.UUID, req v3.DetachInstanceFromPrivateNetworkRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// DetachInstanceFromSecurityGroup mocks v3.Client.DetachInstanceFromSecurityGroup.
+func (m *API) DetachInstanceFromSecurityGroup(ctx context.Context, id v3.UUID, req v3.DetachInstanceFromSecurityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// EnsureAntiAffinityGroup mocks v3.Client.EnsureAntiAffinityGroup.
+func (m *API) EnsureAntiAffinityGroup(ctx context.Context, spec v3.CreateAntiAffinityGroupRequest) (*v3.AntiAffinityGroup, v3.Changes, error) {
+	args := m.Called(ctx, spec)
+	r0, _ := args.Get(0).(*v3.AntiAffinityGroup)
+	r1, _ := args.Get(1).(v3.Changes)
+
+	return r0, r1, args.Error(2)
+}
+
+// EnsureDNSDomainRecord mocks v3.Client.EnsureDNSDomainRecord.
+func (m *API) EnsureDNSDomainRecord(ctx context.Context, domainID v3.UUID, spec v3.CreateDNSDomainRecordRequest, opts ...v3.EnsureDNSDomainRecordOpt) (*v3.DNSDomainRecord, v3.Changes, error) {
+	args := m.Called(ctx, domainID, spec, opts)
+	r0, _ := args.Get(0).(*v3.DNSDomainRecord)
+	r1, _ := args.Get(1).(v3.Changes)
+
+	return r0, r1, args.Error(2)
+}
+
+// EnsurePrivateNetwork mocks v3.Client.EnsurePrivateNetwork.
+func (m *API) EnsurePrivateNetwork(ctx context.Context, spec v3.CreatePrivateNetworkRequest) (*v3.PrivateNetwork, v3.Changes, error) {
+	args := m.Called(ctx, spec)
+	r0, _ := args.Get(0).(*v3.PrivateNetwork)
+	r1, _ := args.Get(1).(v3.Changes)
+
+	return r0, r1, args.Error(2)
+}
+
+// EnsureSSHKey mocks v3.Client.EnsureSSHKey.
+func (m *API) EnsureSSHKey(ctx context.Context, spec v3.RegisterSSHKeyRequest) (*v3.SSHKey, v3.Changes, error) {
+	args := m.Called(ctx, spec)
+	r0, _ := args.Get(0).(*v3.SSHKey)
+	r1, _ := args.Get(1).(v3.Changes)
+
+	return r0, r1, args.Error(2)
+}
+
+// EnsureSecurityGroup mocks v3.Client.EnsureSecurityGroup.
+func (m *API) EnsureSecurityGroup(ctx context.Context, spec v3.CreateSecurityGroupRequest) (*v3.SecurityGroup, v3.Changes, error) {
+	args := m.Called(ctx, spec)
+	r0, _ := args.Get(0).(*v3.SecurityGroup)
+	r1, _ := args.Get(1).(v3.Changes)
+
+	return r0, r1, args.Error(2)
+}
+
+// EvictInstancePoolMembers mocks v3.Client.EvictInstancePoolMembers.
+func (m *API) EvictInstancePoolMembers(ctx context.Context, id v3.UUID, req v3.EvictInstancePoolMembersRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// EvictSKSNodepoolMembers mocks v3.Client.EvictSKSNodepoolMembers.
+func (m *API) EvictSKSNodepoolMembers(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID, req v3.EvictSKSNodepoolMembersRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, sksNodepoolID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ExecuteSKSClusterUpgrade mocks v3.Client.ExecuteSKSClusterUpgrade.
+func (m *API) ExecuteSKSClusterUpgrade(ctx context.Context, plan *v3.SKSClusterUpgradePlan, opts ...v3.ExecuteSKSClusterUpgradeOpt) error {
+	args := m.Called(ctx, plan, opts)
+
+	return args.Error(0)
+}
+
+// ExportSnapshot mocks v3.Client.ExportSnapshot.
+func (m *API) ExportSnapshot(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// GenerateSKSClusterKubeconfig mocks v3.Client.GenerateSKSClusterKubeconfig.
+func (m *API) GenerateSKSClusterKubeconfig(ctx context.Context, id v3.UUID, req v3.SKSKubeconfigRequest) (*v3.GenerateSKSClusterKubeconfigResponse, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.GenerateSKSClusterKubeconfigResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetAPIKey mocks v3.Client.GetAPIKey.
+func (m *API) GetAPIKey(ctx context.Context, id string) (*v3.IAMAPIKey, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.IAMAPIKey)
+
+	return r0, args.Error(1)
+}
+
+// GetAntiAffinityGroup mocks v3.Client.GetAntiAffinityGroup.
+func (m *API) GetAntiAffinityGroup(ctx context.Context, id v3.UUID) (*v3.AntiAffinityGroup, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.AntiAffinityGroup)
+
+	return r0, args.Error(1)
+}
+
+// GetBlockStorageSnapshot mocks v3.Client.GetBlockStorageSnapshot.
+func (m *API) GetBlockStorageSnapshot(ctx context.Context, id v3.UUID) (*v3.BlockStorageSnapshot, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.BlockStorageSnapshot)
+
+	return r0, args.Error(1)
+}
+
+// GetBlockStorageVolume mocks v3.Client.GetBlockStorageVolume.
+func (m *API) GetBlockStorageVolume(ctx context.Context, id v3.UUID) (*v3.BlockStorageVolume, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.BlockStorageVolume)
+
+	return r0, args.Error(1)
+}
+
+// GetConsoleProxyURL mocks v3.Client.GetConsoleProxyURL.
+func (m *API) GetConsoleProxyURL(ctx context.Context, id v3.UUID) (*v3.GetConsoleProxyURLResponse, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.GetConsoleProxyURLResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASCACertificate mocks v3.Client.GetDBAASCACertificate.
+func (m *API) GetDBAASCACertificate(ctx context.Context) (*v3.GetDBAASCACertificateResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASCACertificateResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASConnection mocks v3.Client.GetDBAASConnection.
+func (m *API) GetDBAASConnection(ctx context.Context, name string, opts ...v3.DBAASConnectionOpt) (*v3.DBAASConnection, error) {
+	args := m.Called(ctx, name, opts)
+	r0, _ := args.Get(0).(*v3.DBAASConnection)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASIntegration mocks v3.Client.GetDBAASIntegration.
+func (m *API) GetDBAASIntegration(ctx context.Context, id v3.UUID) (*v3.DBAASIntegration, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.DBAASIntegration)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASKafkaAclConfig mocks v3.Client.GetDBAASKafkaAclConfig.
+func (m *API) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*v3.DBAASKafkaAcls, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASKafkaAcls)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASMigrationStatus mocks v3.Client.GetDBAASMigrationStatus.
+func (m *API) GetDBAASMigrationStatus(ctx context.Context, name string) (*v3.DBAASMigrationStatus, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASMigrationStatus)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASOpensearchAclConfig mocks v3.Client.GetDBAASOpensearchAclConfig.
+func (m *API) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*v3.DBAASOpensearchAclConfig, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASOpensearchAclConfig)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceGrafana mocks v3.Client.GetDBAASServiceGrafana.
+func (m *API) GetDBAASServiceGrafana(ctx context.Context, name string) (*v3.DBAASServiceGrafana, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASServiceGrafana)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceKafka mocks v3.Client.GetDBAASServiceKafka.
+func (m *API) GetDBAASServiceKafka(ctx context.Context, name string) (*v3.DBAASServiceKafka, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASServiceKafka)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceLogs mocks v3.Client.GetDBAASServiceLogs.
+func (m *API) GetDBAASServiceLogs(ctx context.Context, serviceName string, req v3.GetDBAASServiceLogsRequest) (*v3.DBAASServiceLogs, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.DBAASServiceLogs)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceMetrics mocks v3.Client.GetDBAASServiceMetrics.
+func (m *API) GetDBAASServiceMetrics(ctx context.Context, serviceName string, req v3.GetDBAASServiceMetricsRequest) (*v3.GetDBAASServiceMetricsResponse, error) {
+	args := m.Called(ctx, serviceName, req)
+	r0, _ := args.Get(0).(*v3.GetDBAASServiceMetricsResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceMysql mocks v3.Client.GetDBAASServiceMysql.
+func (m *API) GetDBAASServiceMysql(ctx context.Context, name string) (*v3.DBAASServiceMysql, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASServiceMysql)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceOpensearch mocks v3.Client.GetDBAASServiceOpensearch.
+func (m *API) GetDBAASServiceOpensearch(ctx context.Context, name string) (*v3.DBAASServiceOpensearch, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASServiceOpensearch)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServicePG mocks v3.Client.GetDBAASServicePG.
+func (m *API) GetDBAASServicePG(ctx context.Context, name string) (*v3.DBAASServicePG, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASServicePG)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceRedis mocks v3.Client.GetDBAASServiceRedis.
+func (m *API) GetDBAASServiceRedis(ctx context.Context, name string) (*v3.DBAASServiceRedis, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.DBAASServiceRedis)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASServiceType mocks v3.Client.GetDBAASServiceType.
+func (m *API) GetDBAASServiceType(ctx context.Context, serviceTypeName string) (*v3.DBAASServiceType, error) {
+	args := m.Called(ctx, serviceTypeName)
+	r0, _ := args.Get(0).(*v3.DBAASServiceType)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASSettingsGrafana mocks v3.Client.GetDBAASSettingsGrafana.
+func (m *API) GetDBAASSettingsGrafana(ctx context.Context) (*v3.GetDBAASSettingsGrafanaResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASSettingsGrafanaResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASSettingsKafka mocks v3.Client.GetDBAASSettingsKafka.
+func (m *API) GetDBAASSettingsKafka(ctx context.Context) (*v3.GetDBAASSettingsKafkaResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASSettingsKafkaResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASSettingsMysql mocks v3.Client.GetDBAASSettingsMysql.
+func (m *API) GetDBAASSettingsMysql(ctx context.Context) (*v3.GetDBAASSettingsMysqlResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASSettingsMysqlResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASSettingsOpensearch mocks v3.Client.GetDBAASSettingsOpensearch.
+func (m *API) GetDBAASSettingsOpensearch(ctx context.Context) (*v3.GetDBAASSettingsOpensearchResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASSettingsOpensearchResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASSettingsPG mocks v3.Client.GetDBAASSettingsPG.
+func (m *API) GetDBAASSettingsPG(ctx context.Context) (*v3.GetDBAASSettingsPGResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASSettingsPGResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASSettingsRedis mocks v3.Client.GetDBAASSettingsRedis.
+func (m *API) GetDBAASSettingsRedis(ctx context.Context) (*v3.GetDBAASSettingsRedisResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.GetDBAASSettingsRedisResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDBAASTask mocks v3.Client.GetDBAASTask.
+func (m *API) GetDBAASTask(ctx context.Context, service string, id v3.UUID) (*v3.DBAASTask, error) {
+	args := m.Called(ctx, service, id)
+	r0, _ := args.Get(0).(*v3.DBAASTask)
+
+	return r0, args.Error(1)
+}
+
+// GetDNSDomain mocks v3.Client.GetDNSDomain.
+func (m *API) GetDNSDomain(ctx context.Context, id v3.UUID) (*v3.DNSDomain, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.DNSDomain)
+
+	return r0, args.Error(1)
+}
+
+// GetDNSDomainRecord mocks v3.Client.GetDNSDomainRecord.
+func (m *API) GetDNSDomainRecord(ctx context.Context, domainID v3.UUID, recordID v3.UUID) (*v3.DNSDomainRecord, error) {
+	args := m.Called(ctx, domainID, recordID)
+	r0, _ := args.Get(0).(*v3.DNSDomainRecord)
+
+	return r0, args.Error(1)
+}
+
+// GetDNSDomainZoneFile mocks v3.Client.GetDNSDomainZoneFile.
+func (m *API) GetDNSDomainZoneFile(ctx context.Context, id v3.UUID) (*v3.GetDNSDomainZoneFileResponse, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.GetDNSDomainZoneFileResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetDeployTarget mocks v3.Client.GetDeployTarget.
+func (m *API) GetDeployTarget(ctx context.Context, id v3.UUID) (*v3.DeployTarget, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.DeployTarget)
+
+	return r0, args.Error(1)
+}
+
+// GetElasticIP mocks v3.Client.GetElasticIP.
+func (m *API) GetElasticIP(ctx context.Context, id v3.UUID) (*v3.ElasticIP, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.ElasticIP)
+
+	return r0, args.Error(1)
+}
+
+// GetIAMOrganizationPolicy mocks v3.Client.GetIAMOrganizationPolicy.
+func (m *API) GetIAMOrganizationPolicy(ctx context.Context) (*v3.IAMPolicy, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.IAMPolicy)
+
+	return r0, args.Error(1)
+}
+
+// GetIAMRole mocks v3.Client.GetIAMRole.
+func (m *API) GetIAMRole(ctx context.Context, id v3.UUID) (*v3.IAMRole, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.IAMRole)
+
+	return r0, args.Error(1)
+}
+
+// GetInstance mocks v3.Client.GetInstance.
+func (m *API) GetInstance(ctx context.Context, id v3.UUID) (*v3.Instance, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Instance)
+
+	return r0, args.Error(1)
+}
+
+// GetInstancePool mocks v3.Client.GetInstancePool.
+func (m *API) GetInstancePool(ctx context.Context, id v3.UUID) (*v3.InstancePool, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.InstancePool)
+
+	return r0, args.Error(1)
+}
+
+// GetInstanceType mocks v3.Client.GetInstanceType.
+func (m *API) GetInstanceType(ctx context.Context, id v3.UUID) (*v3.InstanceType, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.InstanceType)
+
+	return r0, args.Error(1)
+}
+
+// GetLoadBalancer mocks v3.Client.GetLoadBalancer.
+func (m *API) GetLoadBalancer(ctx context.Context, id v3.UUID) (*v3.LoadBalancer, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.LoadBalancer)
+
+	return r0, args.Error(1)
+}
+
+// GetLoadBalancerService mocks v3.Client.GetLoadBalancerService.
+func (m *API) GetLoadBalancerService(ctx context.Context, id v3.UUID, serviceID v3.UUID) (*v3.LoadBalancerService, error) {
+	args := m.Called(ctx, id, serviceID)
+	r0, _ := args.Get(0).(*v3.LoadBalancerService)
+
+	return r0, args.Error(1)
+}
+
+// GetOperation mocks v3.Client.GetOperation.
+func (m *API) GetOperation(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// GetOrganization mocks v3.Client.GetOrganization.
+func (m *API) GetOrganization(ctx context.Context) (*v3.Organization, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.Organization)
+
+	return r0, args.Error(1)
+}
+
+// GetPrivateNetwork mocks v3.Client.GetPrivateNetwork.
+func (m *API) GetPrivateNetwork(ctx context.Context, id v3.UUID) (*v3.PrivateNetwork, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.PrivateNetwork)
+
+	return r0, args.Error(1)
+}
+
+// GetQuota mocks v3.Client.GetQuota.
+func (m *API) GetQuota(ctx context.Context, entity string) (*v3.Quota, error) {
+	args := m.Called(ctx, entity)
+	r0, _ := args.Get(0).(*v3.Quota)
+
+	return r0, args.Error(1)
+}
+
+// GetReverseDNSElasticIP mocks v3.Client.GetReverseDNSElasticIP.
+func (m *API) GetReverseDNSElasticIP(ctx context.Context, id v3.UUID) (*v3.ReverseDNSRecord, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.ReverseDNSRecord)
+
+	return r0, args.Error(1)
+}
+
+// GetReverseDNSInstance mocks v3.Client.GetReverseDNSInstance.
+func (m *API) GetReverseDNSInstance(ctx context.Context, id v3.UUID) (*v3.ReverseDNSRecord, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.ReverseDNSRecord)
+
+	return r0, args.Error(1)
+}
+
+// GetSKSCluster mocks v3.Client.GetSKSCluster.
+func (m *API) GetSKSCluster(ctx context.Context, id v3.UUID) (*v3.SKSCluster, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.SKSCluster)
+
+	return r0, args.Error(1)
+}
+
+// GetSKSClusterAuthorityCert mocks v3.Client.GetSKSClusterAuthorityCert.
+func (m *API) GetSKSClusterAuthorityCert(ctx context.Context, id v3.UUID, authority v3.GetSKSClusterAuthorityCertAuthority) (*v3.GetSKSClusterAuthorityCertResponse, error) {
+	args := m.Called(ctx, id, authority)
+	r0, _ := args.Get(0).(*v3.GetSKSClusterAuthorityCertResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetSKSClusterInspection mocks v3.Client.GetSKSClusterInspection.
+func (m *API) GetSKSClusterInspection(ctx context.Context, id v3.UUID) (*v3.GetSKSClusterInspectionResponse, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.GetSKSClusterInspectionResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetSKSNodepool mocks v3.Client.GetSKSNodepool.
+func (m *API) GetSKSNodepool(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID) (*v3.SKSNodepool, error) {
+	args := m.Called(ctx, id, sksNodepoolID)
+	r0, _ := args.Get(0).(*v3.SKSNodepool)
+
+	return r0, args.Error(1)
+}
+
+// GetSOSPresignedURL mocks v3.Client.GetSOSPresignedURL.
+func (m *API) GetSOSPresignedURL(ctx context.Context, bucket string, opts ...v3.GetSOSPresignedURLOpt) (*v3.GetSOSPresignedURLResponse, error) {
+	args := m.Called(ctx, bucket, opts)
+	r0, _ := args.Get(0).(*v3.GetSOSPresignedURLResponse)
+
+	return r0, args.Error(1)
+}
+
+// GetSSHKey mocks v3.Client.GetSSHKey.
+func (m *API) GetSSHKey(ctx context.Context, name string) (*v3.SSHKey, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.SSHKey)
+
+	return r0, args.Error(1)
+}
+
+// GetSecurityGroup mocks v3.Client.GetSecurityGroup.
+func (m *API) GetSecurityGroup(ctx context.Context, id v3.UUID) (*v3.SecurityGroup, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.SecurityGroup)
+
+	return r0, args.Error(1)
+}
+
+// GetSnapshot mocks v3.Client.GetSnapshot.
+func (m *API) GetSnapshot(ctx context.Context, id v3.UUID) (*v3.Snapshot, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Snapshot)
+
+	return r0, args.Error(1)
+}
+
+// GetTemplate mocks v3.Client.GetTemplate.
+func (m *API) GetTemplate(ctx context.Context, id v3.UUID) (*v3.Template, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Template)
+
+	return r0, args.Error(1)
+}
+
+// GetZoneAPIEndpoint mocks v3.Client.GetZoneAPIEndpoint.
+func (m *API) GetZoneAPIEndpoint(ctx context.Context, zoneName v3.ZoneName) (v3.Endpoint, error) {
+	args := m.Called(ctx, zoneName)
+	r0, _ := args.Get(0).(v3.Endpoint)
+
+	return r0, args.Error(1)
+}
+
+// GetZoneName mocks v3.Client.GetZoneName.
+func (m *API) GetZoneName(ctx context.Context, endpoint v3.Endpoint) (v3.ZoneName, error) {
+	args := m.Called(ctx, endpoint)
+	r0, _ := args.Get(0).(v3.ZoneName)
+
+	return r0, args.Error(1)
+}
+
+// ListAPIKeys mocks v3.Client.ListAPIKeys.
+func (m *API) ListAPIKeys(ctx context.Context) (*v3.ListAPIKeysResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListAPIKeysResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListAntiAffinityGroups mocks v3.Client.ListAntiAffinityGroups.
+func (m *API) ListAntiAffinityGroups(ctx context.Context) (*v3.ListAntiAffinityGroupsResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListAntiAffinityGroupsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListBlockStorageSnapshots mocks v3.Client.ListBlockStorageSnapshots.
+func (m *API) ListBlockStorageSnapshots(ctx context.Context) (*v3.ListBlockStorageSnapshotsResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListBlockStorageSnapshotsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListBlockStorageVolumes mocks v3.Client.ListBlockStorageVolumes.
+func (m *API) ListBlockStorageVolumes(ctx context.Context, opts ...v3.ListBlockStorageVolumesOpt) (*v3.ListBlockStorageVolumesResponse, error) {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(*v3.ListBlockStorageVolumesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDBAASIntegrationSettings mocks v3.Client.ListDBAASIntegrationSettings.
+func (m *API) ListDBAASIntegrationSettings(ctx context.Context, integrationType string, sourceType string, destType string) (*v3.ListDBAASIntegrationSettingsResponse, error) {
+	args := m.Called(ctx, integrationType, sourceType, destType)
+	r0, _ := args.Get(0).(*v3.ListDBAASIntegrationSettingsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDBAASIntegrationTypes mocks v3.Client.ListDBAASIntegrationTypes.
+func (m *API) ListDBAASIntegrationTypes(ctx context.Context) (*v3.ListDBAASIntegrationTypesResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListDBAASIntegrationTypesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDBAASServiceTypes mocks v3.Client.ListDBAASServiceTypes.
+func (m *API) ListDBAASServiceTypes(ctx context.Context) (*v3.ListDBAASServiceTypesResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListDBAASServiceTypesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDBAASServices mocks v3.Client.ListDBAASServices.
+func (m *API) ListDBAASServices(ctx context.Context) (*v3.ListDBAASServicesResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListDBAASServicesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDNSDomainRecords mocks v3.Client.ListDNSDomainRecords.
+func (m *API) ListDNSDomainRecords(ctx context.Context, domainID v3.UUID) (*v3.ListDNSDomainRecordsResponse, error) {
+	args := m.Called(ctx, domainID)
+	r0, _ := args.Get(0).(*v3.ListDNSDomainRecordsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDNSDomains mocks v3.Client.ListDNSDomains.
+func (m *API) ListDNSDomains(ctx context.Context) (*v3.ListDNSDomainsResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListDNSDomainsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListDeployTargets mocks v3.Client.ListDeployTargets.
+func (m *API) ListDeployTargets(ctx context.Context) (*v3.ListDeployTargetsResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListDeployTargetsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListElasticIPS mocks v3.Client.ListElasticIPS.
+func (m *API) ListElasticIPS(ctx context.Context) (*v3.ListElasticIPSResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListElasticIPSResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListEvents mocks v3.Client.ListEvents.
+func (m *API) ListEvents(ctx context.Context, opts ...v3.ListEventsOpt) ([]v3.Event, error) {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).([]v3.Event)
+
+	return r0, args.Error(1)
+}
+
+// ListIAMRoles mocks v3.Client.ListIAMRoles.
+func (m *API) ListIAMRoles(ctx context.Context) (*v3.ListIAMRolesResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListIAMRolesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListInstancePools mocks v3.Client.ListInstancePools.
+func (m *API) ListInstancePools(ctx context.Context) (*v3.ListInstancePoolsResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListInstancePoolsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListInstanceTypes mocks v3.Client.ListInstanceTypes.
+func (m *API) ListInstanceTypes(ctx context.Context) (*v3.ListInstanceTypesResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListInstanceTypesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListInstances mocks v3.Client.ListInstances.
+func (m *API) ListInstances(ctx context.Context, opts ...v3.ListInstancesOpt) (*v3.ListInstancesResponse, error) {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(*v3.ListInstancesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListLoadBalancers mocks v3.Client.ListLoadBalancers.
+func (m *API) ListLoadBalancers(ctx context.Context) (*v3.ListLoadBalancersResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListLoadBalancersResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListPrivateNetworks mocks v3.Client.ListPrivateNetworks.
+func (m *API) ListPrivateNetworks(ctx context.Context) (*v3.ListPrivateNetworksResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListPrivateNetworksResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListQuotas mocks v3.Client.ListQuotas.
+func (m *API) ListQuotas(ctx context.Context) (*v3.ListQuotasResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListQuotasResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListSKSClusterDeprecatedResources mocks v3.Client.ListSKSClusterDeprecatedResources.
+func (m *API) ListSKSClusterDeprecatedResources(ctx context.Context, id v3.UUID) ([]v3.SKSClusterDeprecatedResource, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).([]v3.SKSClusterDeprecatedResource)
+
+	return r0, args.Error(1)
+}
+
+// ListSKSClusterVersions mocks v3.Client.ListSKSClusterVersions.
+func (m *API) ListSKSClusterVersions(ctx context.Context, opts ...v3.ListSKSClusterVersionsOpt) (*v3.ListSKSClusterVersionsResponse, error) {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(*v3.ListSKSClusterVersionsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListSKSClusters mocks v3.Client.ListSKSClusters.
+func (m *API) ListSKSClusters(ctx context.Context) (*v3.ListSKSClustersResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListSKSClustersResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListSOSBucketsUsage mocks v3.Client.ListSOSBucketsUsage.
+func (m *API) ListSOSBucketsUsage(ctx context.Context) (*v3.ListSOSBucketsUsageResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListSOSBucketsUsageResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListSSHKeys mocks v3.Client.ListSSHKeys.
+func (m *API) ListSSHKeys(ctx context.Context) (*v3.ListSSHKeysResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListSSHKeysResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListSecurityGroups mocks v3.Client.ListSecurityGroups.
+func (m *API) ListSecurityGroups(ctx context.Context, opts ...v3.ListSecurityGroupsOpt) (*v3.ListSecurityGroupsResponse, error) {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(*v3.ListSecurityGroupsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListSnapshots mocks v3.Client.ListSnapshots.
+func (m *API) ListSnapshots(ctx context.Context) (*v3.ListSnapshotsResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListSnapshotsResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListTemplates mocks v3.Client.ListTemplates.
+func (m *API) ListTemplates(ctx context.Context, opts ...v3.ListTemplatesOpt) (*v3.ListTemplatesResponse, error) {
+	args := m.Called(ctx, opts)
+	r0, _ := args.Get(0).(*v3.ListTemplatesResponse)
+
+	return r0, args.Error(1)
+}
+
+// ListZones mocks v3.Client.ListZones.
+func (m *API) ListZones(ctx context.Context) (*v3.ListZonesResponse, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*v3.ListZonesResponse)
+
+	return r0, args.Error(1)
+}
+
+// PlanSKSClusterUpgrade mocks v3.Client.PlanSKSClusterUpgrade.
+func (m *API) PlanSKSClusterUpgrade(ctx context.Context, id v3.UUID, version string, opts ...v3.PlanSKSClusterUpgradeOpt) (*v3.SKSClusterUpgradePlan, error) {
+	args := m.Called(ctx, id, version, opts)
+	r0, _ := args.Get(0).(*v3.SKSClusterUpgradePlan)
+
+	return r0, args.Error(1)
+}
+
+// PromoteSnapshotToTemplate mocks v3.Client.PromoteSnapshotToTemplate.
+func (m *API) PromoteSnapshotToTemplate(ctx context.Context, id v3.UUID, req v3.PromoteSnapshotToTemplateRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RebootInstance mocks v3.Client.RebootInstance.
+func (m *API) RebootInstance(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RegisterSSHKey mocks v3.Client.RegisterSSHKey.
+func (m *API) RegisterSSHKey(ctx context.Context, req v3.RegisterSSHKeyRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RegisterTemplate mocks v3.Client.RegisterTemplate.
+func (m *API) RegisterTemplate(ctx context.Context, req v3.RegisterTemplateRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RemoveExternalSourceFromSecurityGroup mocks v3.Client.RemoveExternalSourceFromSecurityGroup.
+func (m *API) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id v3.UUID, req v3.RemoveExternalSourceFromSecurityGroupRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RemoveInstanceProtection mocks v3.Client.RemoveInstanceProtection.
+func (m *API) RemoveInstanceProtection(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ReportAPIKeyAge mocks v3.Client.ReportAPIKeyAge.
+func (m *API) ReportAPIKeyAge(ctx context.Context, maxAge time.Duration) (*v3.APIKeyAgeReport, error) {
+	args := m.Called(ctx, maxAge)
+	r0, _ := args.Get(0).(*v3.APIKeyAgeReport)
+
+	return r0, args.Error(1)
+}
+
+// ResetDBAASGrafanaUserPassword mocks v3.Client.ResetDBAASGrafanaUserPassword.
+func (m *API) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string, req v3.ResetDBAASGrafanaUserPasswordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetDBAASKafkaUserPassword mocks v3.Client.ResetDBAASKafkaUserPassword.
+func (m *API) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string, req v3.ResetDBAASKafkaUserPasswordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetDBAASMysqlUserPassword mocks v3.Client.ResetDBAASMysqlUserPassword.
+func (m *API) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string, req v3.ResetDBAASMysqlUserPasswordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetDBAASOpensearchUserPassword mocks v3.Client.ResetDBAASOpensearchUserPassword.
+func (m *API) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string, req v3.ResetDBAASOpensearchUserPasswordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetDBAASPostgresUserPassword mocks v3.Client.ResetDBAASPostgresUserPassword.
+func (m *API) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string, req v3.ResetDBAASPostgresUserPasswordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetDBAASRedisUserPassword mocks v3.Client.ResetDBAASRedisUserPassword.
+func (m *API) ResetDBAASRedisUserPassword(ctx context.Context, serviceName string, username string, req v3.ResetDBAASRedisUserPasswordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetElasticIPField mocks v3.Client.ResetElasticIPField.
+func (m *API) ResetElasticIPField(ctx context.Context, id v3.UUID, field v3.ResetElasticIPFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetInstance mocks v3.Client.ResetInstance.
+func (m *API) ResetInstance(ctx context.Context, id v3.UUID, req v3.ResetInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetInstanceField mocks v3.Client.ResetInstanceField.
+func (m *API) ResetInstanceField(ctx context.Context, id v3.UUID, field v3.ResetInstanceFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetInstancePassword mocks v3.Client.ResetInstancePassword.
+func (m *API) ResetInstancePassword(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetInstancePoolField mocks v3.Client.ResetInstancePoolField.
+func (m *API) ResetInstancePoolField(ctx context.Context, id v3.UUID, field v3.ResetInstancePoolFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetLoadBalancerField mocks v3.Client.ResetLoadBalancerField.
+func (m *API) ResetLoadBalancerField(ctx context.Context, id v3.UUID, field v3.ResetLoadBalancerFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetLoadBalancerServiceField mocks v3.Client.ResetLoadBalancerServiceField.
+func (m *API) ResetLoadBalancerServiceField(ctx context.Context, id v3.UUID, serviceID v3.UUID, field v3.ResetLoadBalancerServiceFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, serviceID, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetPrivateNetworkField mocks v3.Client.ResetPrivateNetworkField.
+func (m *API) ResetPrivateNetworkField(ctx context.Context, id v3.UUID, field v3.ResetPrivateNetworkFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetSKSClusterField mocks v3.Client.ResetSKSClusterField.
+func (m *API) ResetSKSClusterField(ctx context.Context, id v3.UUID, field v3.ResetSKSClusterFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResetSKSNodepoolField mocks v3.Client.ResetSKSNodepoolField.
+func (m *API) ResetSKSNodepoolField(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID, field v3.ResetSKSNodepoolFieldField) (*v3.Operation, error) {
+	args := m.Called(ctx, id, sksNodepoolID, field)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ResizeBlockStorageVolume mocks v3.Client.ResizeBlockStorageVolume.
+func (m *API) ResizeBlockStorageVolume(ctx context.Context, id v3.UUID, req v3.ResizeBlockStorageVolumeRequest) (*v3.BlockStorageVolume, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.BlockStorageVolume)
+
+	return r0, args.Error(1)
+}
+
+// ResizeInstanceDisk mocks v3.Client.ResizeInstanceDisk.
+func (m *API) ResizeInstanceDisk(ctx context.Context, id v3.UUID, req v3.ResizeInstanceDiskRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RevealDBAASGrafanaUserPassword mocks v3.Client.RevealDBAASGrafanaUserPassword.
+func (m *API) RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string) (*v3.DBAASUserGrafanaSecrets, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.DBAASUserGrafanaSecrets)
+
+	return r0, args.Error(1)
+}
+
+// RevealDBAASKafkaUserPassword mocks v3.Client.RevealDBAASKafkaUserPassword.
+func (m *API) RevealDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string) (*v3.DBAASUserKafkaSecrets, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.DBAASUserKafkaSecrets)
+
+	return r0, args.Error(1)
+}
+
+// RevealDBAASMysqlUserPassword mocks v3.Client.RevealDBAASMysqlUserPassword.
+func (m *API) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string) (*v3.DBAASUserMysqlSecrets, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.DBAASUserMysqlSecrets)
+
+	return r0, args.Error(1)
+}
+
+// RevealDBAASOpensearchUserPassword mocks v3.Client.RevealDBAASOpensearchUserPassword.
+func (m *API) RevealDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string) (*v3.DBAASUserOpensearchSecrets, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.DBAASUserOpensearchSecrets)
+
+	return r0, args.Error(1)
+}
+
+// RevealDBAASPostgresUserPassword mocks v3.Client.RevealDBAASPostgresUserPassword.
+func (m *API) RevealDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string) (*v3.DBAASUserPostgresSecrets, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.DBAASUserPostgresSecrets)
+
+	return r0, args.Error(1)
+}
+
+// RevealDBAASRedisUserPassword mocks v3.Client.RevealDBAASRedisUserPassword.
+func (m *API) RevealDBAASRedisUserPassword(ctx context.Context, serviceName string, username string) (*v3.DBAASUserRedisSecrets, error) {
+	args := m.Called(ctx, serviceName, username)
+	r0, _ := args.Get(0).(*v3.DBAASUserRedisSecrets)
+
+	return r0, args.Error(1)
+}
+
+// RevealInstancePassword mocks v3.Client.RevealInstancePassword.
+func (m *API) RevealInstancePassword(ctx context.Context, id v3.UUID) (*v3.InstancePassword, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.InstancePassword)
+
+	return r0, args.Error(1)
+}
+
+// RevertInstanceToSnapshot mocks v3.Client.RevertInstanceToSnapshot.
+func (m *API) RevertInstanceToSnapshot(ctx context.Context, instanceID v3.UUID, req v3.RevertInstanceToSnapshotRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, instanceID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RollInstancePool mocks v3.Client.RollInstancePool.
+func (m *API) RollInstancePool(ctx context.Context, id v3.UUID, opts ...v3.RollInstancePoolOpt) error {
+	args := m.Called(ctx, id, opts)
+
+	return args.Error(0)
+}
+
+// RotateAPIKey mocks v3.Client.RotateAPIKey.
+func (m *API) RotateAPIKey(ctx context.Context, key string, sink v3.APIKeySink, opts ...v3.RotateAPIKeyOpt) (*v3.IAMAPIKeyCreated, error) {
+	args := m.Called(ctx, key, sink, opts)
+	r0, _ := args.Get(0).(*v3.IAMAPIKeyCreated)
+
+	return r0, args.Error(1)
+}
+
+// RotateSKSCcmCredentials mocks v3.Client.RotateSKSCcmCredentials.
+func (m *API) RotateSKSCcmCredentials(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// RotateSKSOperatorsCA mocks v3.Client.RotateSKSOperatorsCA.
+func (m *API) RotateSKSOperatorsCA(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ScaleInstance mocks v3.Client.ScaleInstance.
+func (m *API) ScaleInstance(ctx context.Context, id v3.UUID, req v3.ScaleInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ScaleInstancePool mocks v3.Client.ScaleInstancePool.
+func (m *API) ScaleInstancePool(ctx context.Context, id v3.UUID, req v3.ScaleInstancePoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// ScaleSKSNodepool mocks v3.Client.ScaleSKSNodepool.
+func (m *API) ScaleSKSNodepool(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID, req v3.ScaleSKSNodepoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, sksNodepoolID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartDBAASGrafanaMaintenance mocks v3.Client.StartDBAASGrafanaMaintenance.
+func (m *API) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartDBAASKafkaMaintenance mocks v3.Client.StartDBAASKafkaMaintenance.
+func (m *API) StartDBAASKafkaMaintenance(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartDBAASMysqlMaintenance mocks v3.Client.StartDBAASMysqlMaintenance.
+func (m *API) StartDBAASMysqlMaintenance(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartDBAASOpensearchMaintenance mocks v3.Client.StartDBAASOpensearchMaintenance.
+func (m *API) StartDBAASOpensearchMaintenance(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartDBAASPGMaintenance mocks v3.Client.StartDBAASPGMaintenance.
+func (m *API) StartDBAASPGMaintenance(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartDBAASRedisMaintenance mocks v3.Client.StartDBAASRedisMaintenance.
+func (m *API) StartDBAASRedisMaintenance(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StartInstance mocks v3.Client.StartInstance.
+func (m *API) StartInstance(ctx context.Context, id v3.UUID, req v3.StartInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StopDBAASMysqlMigration mocks v3.Client.StopDBAASMysqlMigration.
+func (m *API) StopDBAASMysqlMigration(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StopDBAASPGMigration mocks v3.Client.StopDBAASPGMigration.
+func (m *API) StopDBAASPGMigration(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StopDBAASRedisMigration mocks v3.Client.StopDBAASRedisMigration.
+func (m *API) StopDBAASRedisMigration(ctx context.Context, name string) (*v3.Operation, error) {
+	args := m.Called(ctx, name)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// StopInstance mocks v3.Client.StopInstance.
+func (m *API) StopInstance(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// SyncSecurityGroupRules mocks v3.Client.SyncSecurityGroupRules.
+func (m *API) SyncSecurityGroupRules(ctx context.Context, groupID v3.UUID, desired []v3.SecurityGroupRule, opts ...v3.SyncSecurityGroupRulesOpt) (*v3.SecurityGroupRulesPlan, error) {
+	args := m.Called(ctx, groupID, desired, opts)
+	r0, _ := args.Get(0).(*v3.SecurityGroupRulesPlan)
+
+	return r0, args.Error(1)
+}
+
+// TailDBAASServiceLogs mocks v3.Client.TailDBAASServiceLogs.
+func (m *API) TailDBAASServiceLogs(ctx context.Context, serviceName string, opts ...v3.TailDBAASServiceLogsOpt) iter.Seq2[v3.DBAASServiceLogsLogs, error] {
+	args := m.Called(ctx, serviceName, opts)
+	r0, _ := args.Get(0).(iter.Seq2[v3.DBAASServiceLogsLogs, error])
+
+	return r0
+}
+
+// UpdateBlockStorageSnapshot mocks v3.Client.UpdateBlockStorageSnapshot.
+func (m *API) UpdateBlockStorageSnapshot(ctx context.Context, id v3.UUID, req v3.UpdateBlockStorageSnapshotRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateBlockStorageVolume mocks v3.Client.UpdateBlockStorageVolume.
+func (m *API) UpdateBlockStorageVolume(ctx context.Context, id v3.UUID, req v3.UpdateBlockStorageVolumeRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASIntegration mocks v3.Client.UpdateDBAASIntegration.
+func (m *API) UpdateDBAASIntegration(ctx context.Context, id v3.UUID, req v3.UpdateDBAASIntegrationRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASOpensearchAclConfig mocks v3.Client.UpdateDBAASOpensearchAclConfig.
+func (m *API) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string, req v3.DBAASOpensearchAclConfig) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASPGConnectionPool mocks v3.Client.UpdateDBAASPGConnectionPool.
+func (m *API) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string, req v3.UpdateDBAASPGConnectionPoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, serviceName, connectionPoolName, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASPostgresAllowReplication mocks v3.Client.UpdateDBAASPostgresAllowReplication.
+func (m *API) UpdateDBAASPostgresAllowReplication(ctx context.Context, serviceName string, username string, req v3.UpdateDBAASPostgresAllowReplicationRequest) (*v3.DBAASPostgresUsers, error) {
+	args := m.Called(ctx, serviceName, username, req)
+	r0, _ := args.Get(0).(*v3.DBAASPostgresUsers)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASServiceGrafana mocks v3.Client.UpdateDBAASServiceGrafana.
+func (m *API) UpdateDBAASServiceGrafana(ctx context.Context, name string, req v3.UpdateDBAASServiceGrafanaRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASServiceKafka mocks v3.Client.UpdateDBAASServiceKafka.
+func (m *API) UpdateDBAASServiceKafka(ctx context.Context, name string, req v3.UpdateDBAASServiceKafkaRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASServiceMysql mocks v3.Client.UpdateDBAASServiceMysql.
+func (m *API) UpdateDBAASServiceMysql(ctx context.Context, name string, req v3.UpdateDBAASServiceMysqlRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASServiceOpensearch mocks v3.Client.UpdateDBAASServiceOpensearch.
+func (m *API) UpdateDBAASServiceOpensearch(ctx context.Context, name string, req v3.UpdateDBAASServiceOpensearchRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASServicePG mocks v3.Client.UpdateDBAASServicePG.
+func (m *API) UpdateDBAASServicePG(ctx context.Context, name string, req v3.UpdateDBAASServicePGRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDBAASServiceRedis mocks v3.Client.UpdateDBAASServiceRedis.
+func (m *API) UpdateDBAASServiceRedis(ctx context.Context, name string, req v3.UpdateDBAASServiceRedisRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, name, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateDNSDomainRecord mocks v3.Client.UpdateDNSDomainRecord.
+func (m *API) UpdateDNSDomainRecord(ctx context.Context, domainID v3.UUID, recordID v3.UUID, req v3.UpdateDNSDomainRecordRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, domainID, recordID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateElasticIP mocks v3.Client.UpdateElasticIP.
+func (m *API) UpdateElasticIP(ctx context.Context, id v3.UUID, req v3.UpdateElasticIPRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateIAMOrganizationPolicy mocks v3.Client.UpdateIAMOrganizationPolicy.
+func (m *API) UpdateIAMOrganizationPolicy(ctx context.Context, req v3.IAMPolicy) (*v3.Operation, error) {
+	args := m.Called(ctx, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateIAMRole mocks v3.Client.UpdateIAMRole.
+func (m *API) UpdateIAMRole(ctx context.Context, id v3.UUID, req v3.UpdateIAMRoleRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateIAMRolePolicy mocks v3.Client.UpdateIAMRolePolicy.
+func (m *API) UpdateIAMRolePolicy(ctx context.Context, id v3.UUID, req v3.IAMPolicy) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateInstance mocks v3.Client.UpdateInstance.
+func (m *API) UpdateInstance(ctx context.Context, id v3.UUID, req v3.UpdateInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateInstancePool mocks v3.Client.UpdateInstancePool.
+func (m *API) UpdateInstancePool(ctx context.Context, id v3.UUID, req v3.UpdateInstancePoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateLoadBalancer mocks v3.Client.UpdateLoadBalancer.
+func (m *API) UpdateLoadBalancer(ctx context.Context, id v3.UUID, req v3.UpdateLoadBalancerRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateLoadBalancerService mocks v3.Client.UpdateLoadBalancerService.
+func (m *API) UpdateLoadBalancerService(ctx context.Context, id v3.UUID, serviceID v3.UUID, req v3.UpdateLoadBalancerServiceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, serviceID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdatePrivateNetwork mocks v3.Client.UpdatePrivateNetwork.
+func (m *API) UpdatePrivateNetwork(ctx context.Context, id v3.UUID, req v3.UpdatePrivateNetworkRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdatePrivateNetworkInstanceIP mocks v3.Client.UpdatePrivateNetworkInstanceIP.
+func (m *API) UpdatePrivateNetworkInstanceIP(ctx context.Context, id v3.UUID, req v3.UpdatePrivateNetworkInstanceIPRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateReverseDNSElasticIP mocks v3.Client.UpdateReverseDNSElasticIP.
+func (m *API) UpdateReverseDNSElasticIP(ctx context.Context, id v3.UUID, req v3.UpdateReverseDNSElasticIPRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateReverseDNSInstance mocks v3.Client.UpdateReverseDNSInstance.
+func (m *API) UpdateReverseDNSInstance(ctx context.Context, id v3.UUID, req v3.UpdateReverseDNSInstanceRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateSKSCluster mocks v3.Client.UpdateSKSCluster.
+func (m *API) UpdateSKSCluster(ctx context.Context, id v3.UUID, req v3.UpdateSKSClusterRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateSKSNodepool mocks v3.Client.UpdateSKSNodepool.
+func (m *API) UpdateSKSNodepool(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID, req v3.UpdateSKSNodepoolRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, sksNodepoolID, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpdateTemplate mocks v3.Client.UpdateTemplate.
+func (m *API) UpdateTemplate(ctx context.Context, id v3.UUID, req v3.UpdateTemplateRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpgradeSKSCluster mocks v3.Client.UpgradeSKSCluster.
+func (m *API) UpgradeSKSCluster(ctx context.Context, id v3.UUID, req v3.UpgradeSKSClusterRequest) (*v3.Operation, error) {
+	args := m.Called(ctx, id, req)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// UpgradeSKSClusterServiceLevel mocks v3.Client.UpgradeSKSClusterServiceLevel.
+func (m *API) UpgradeSKSClusterServiceLevel(ctx context.Context, id v3.UUID) (*v3.Operation, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// Validate mocks v3.Client.Validate.
+func (m *API) Validate(s any) error {
+	args := m.Called(s)
+
+	return args.Error(0)
+}
+
+// Wait mocks v3.Client.Wait.
+func (m *API) Wait(ctx context.Context, op *v3.Operation, states ...v3.OperationState) (*v3.Operation, error) {
+	args := m.Called(ctx, op, states)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// WaitBlockStorageSnapshotState mocks v3.Client.WaitBlockStorageSnapshotState.
+func (m *API) WaitBlockStorageSnapshotState(ctx context.Context, id v3.UUID, state v3.BlockStorageSnapshotState, opts ...v3.WaitOpt) (*v3.BlockStorageSnapshot, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.BlockStorageSnapshot)
+
+	return r0, args.Error(1)
+}
+
+// WaitBlockStorageVolumeState mocks v3.Client.WaitBlockStorageVolumeState.
+func (m *API) WaitBlockStorageVolumeState(ctx context.Context, id v3.UUID, state v3.BlockStorageVolumeState, opts ...v3.WaitOpt) (*v3.BlockStorageVolume, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.BlockStorageVolume)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServiceGrafanaState mocks v3.Client.WaitDBAASServiceGrafanaState.
+func (m *API) WaitDBAASServiceGrafanaState(ctx context.Context, name string, state v3.EnumServiceState, opts ...v3.WaitOpt) (*v3.DBAASServiceGrafana, error) {
+	args := m.Called(ctx, name, state, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServiceGrafana)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServiceKafkaState mocks v3.Client.WaitDBAASServiceKafkaState.
+func (m *API) WaitDBAASServiceKafkaState(ctx context.Context, name string, state v3.EnumServiceState, opts ...v3.WaitOpt) (*v3.DBAASServiceKafka, error) {
+	args := m.Called(ctx, name, state, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServiceKafka)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServiceMysqlState mocks v3.Client.WaitDBAASServiceMysqlState.
+func (m *API) WaitDBAASServiceMysqlState(ctx context.Context, name string, state v3.EnumServiceState, opts ...v3.WaitOpt) (*v3.DBAASServiceMysql, error) {
+	args := m.Called(ctx, name, state, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServiceMysql)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServiceOpensearchState mocks v3.Client.WaitDBAASServiceOpensearchState.
+func (m *API) WaitDBAASServiceOpensearchState(ctx context.Context, name string, state v3.EnumServiceState, opts ...v3.WaitOpt) (*v3.DBAASServiceOpensearch, error) {
+	args := m.Called(ctx, name, state, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServiceOpensearch)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServicePGState mocks v3.Client.WaitDBAASServicePGState.
+func (m *API) WaitDBAASServicePGState(ctx context.Context, name string, state v3.EnumServiceState, opts ...v3.WaitOpt) (*v3.DBAASServicePG, error) {
+	args := m.Called(ctx, name, state, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServicePG)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServiceRedisState mocks v3.Client.WaitDBAASServiceRedisState.
+func (m *API) WaitDBAASServiceRedisState(ctx context.Context, name string, state v3.EnumServiceState, opts ...v3.WaitOpt) (*v3.DBAASServiceRedis, error) {
+	args := m.Called(ctx, name, state, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServiceRedis)
+
+	return r0, args.Error(1)
+}
+
+// WaitDBAASServiceRunning mocks v3.Client.WaitDBAASServiceRunning.
+func (m *API) WaitDBAASServiceRunning(ctx context.Context, name string, opts ...v3.WaitOpt) (*v3.DBAASServiceCommon, error) {
+	args := m.Called(ctx, name, opts)
+	r0, _ := args.Get(0).(*v3.DBAASServiceCommon)
+
+	return r0, args.Error(1)
+}
+
+// WaitInstancePoolState mocks v3.Client.WaitInstancePoolState.
+func (m *API) WaitInstancePoolState(ctx context.Context, id v3.UUID, state v3.InstancePoolState, opts ...v3.WaitOpt) (*v3.InstancePool, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.InstancePool)
+
+	return r0, args.Error(1)
+}
+
+// WaitInstanceState mocks v3.Client.WaitInstanceState.
+func (m *API) WaitInstanceState(ctx context.Context, id v3.UUID, state v3.InstanceState, opts ...v3.WaitOpt) (*v3.Instance, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.Instance)
+
+	return r0, args.Error(1)
+}
+
+// WaitLoadBalancerServiceState mocks v3.Client.WaitLoadBalancerServiceState.
+func (m *API) WaitLoadBalancerServiceState(ctx context.Context, id v3.UUID, serviceID v3.UUID, state v3.LoadBalancerServiceState, opts ...v3.WaitOpt) (*v3.LoadBalancerService, error) {
+	args := m.Called(ctx, id, serviceID, state, opts)
+	r0, _ := args.Get(0).(*v3.LoadBalancerService)
+
+	return r0, args.Error(1)
+}
+
+// WaitLoadBalancerState mocks v3.Client.WaitLoadBalancerState.
+func (m *API) WaitLoadBalancerState(ctx context.Context, id v3.UUID, state v3.LoadBalancerState, opts ...v3.WaitOpt) (*v3.LoadBalancer, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.LoadBalancer)
+
+	return r0, args.Error(1)
+}
+
+// WaitOperation mocks v3.Client.WaitOperation.
+func (m *API) WaitOperation(ctx context.Context, op *v3.Operation, opts ...v3.WaitOpt) (*v3.Operation, error) {
+	args := m.Called(ctx, op, opts)
+	r0, _ := args.Get(0).(*v3.Operation)
+
+	return r0, args.Error(1)
+}
+
+// WaitSKSClusterState mocks v3.Client.WaitSKSClusterState.
+func (m *API) WaitSKSClusterState(ctx context.Context, id v3.UUID, state v3.SKSClusterState, opts ...v3.WaitOpt) (*v3.SKSCluster, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.SKSCluster)
+
+	return r0, args.Error(1)
+}
+
+// WaitSKSNodepoolState mocks v3.Client.WaitSKSNodepoolState.
+func (m *API) WaitSKSNodepoolState(ctx context.Context, id v3.UUID, sksNodepoolID v3.UUID, state v3.SKSNodepoolState, opts ...v3.WaitOpt) (*v3.SKSNodepool, error) {
+	args := m.Called(ctx, id, sksNodepoolID, state, opts)
+	r0, _ := args.Get(0).(*v3.SKSNodepool)
+
+	return r0, args.Error(1)
+}
+
+// WaitSnapshotState mocks v3.Client.WaitSnapshotState.
+func (m *API) WaitSnapshotState(ctx context.Context, id v3.UUID, state v3.SnapshotState, opts ...v3.WaitOpt) (*v3.Snapshot, error) {
+	args := m.Called(ctx, id, state, opts)
+	r0, _ := args.Get(0).(*v3.Snapshot)
+
+	return r0, args.Error(1)
+}
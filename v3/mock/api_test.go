@@ -0,0 +1,29 @@
+package mock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+	id := v3.UUID("a8d6bbe9-4e3d-4d0b-8a4d-2a5e3f0a7d61")
+
+	m := NewAPI(t)
+	m.On("GetInstance", ctx, id).Return(&v3.Instance{ID: id, State: v3.InstanceStateRunning}, nil).Once()
+	m.On("GetInstance", ctx, id).Return(nil, v3.ErrNotFound).Once()
+
+	var api v3.API = m
+
+	instance, err := api.GetInstance(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, v3.InstanceStateRunning, instance.State)
+
+	instance, err = api.GetInstance(ctx, id)
+	require.Nil(t, instance)
+	require.ErrorIs(t, err, v3.ErrNotFound)
+}
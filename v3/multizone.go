@@ -0,0 +1,252 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultMultiZoneConcurrency is the default number of zones queried concurrently.
+const defaultMultiZoneConcurrency = 4
+
+// ZoneItem represents a resource tagged with the zone it was listed from.
+type ZoneItem[T any] struct {
+	Zone ZoneName
+	Item T
+}
+
+// ZoneErrors represents the errors returned by a multi-zone call, per zone.
+type ZoneErrors map[ZoneName]error
+
+// Error implements the error interface.
+func (e ZoneErrors) Error() string {
+	zones := make([]string, 0, len(e))
+	for zone := range e {
+		zones = append(zones, string(zone))
+	}
+	sort.Strings(zones)
+
+	msgs := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", zone, e[ZoneName(zone)]))
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the zone errors, for use with errors.Is and errors.As.
+func (e ZoneErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// MultiZoneClient represents a client running calls across several zones.
+type MultiZoneClient struct {
+	client      *Client
+	zones       []ZoneName
+	concurrency int
+
+	mu         sync.Mutex
+	discovered []Zone
+}
+
+// MultiZoneClientOpt represents a function setting a MultiZoneClient option.
+type MultiZoneClientOpt func(*MultiZoneClient) error
+
+// MultiZoneClientOptWithZones returns a MultiZoneClientOpt restricting the calls
+// to the given zones instead of all the zones returned by ListZones.
+func MultiZoneClientOptWithZones(zones ...ZoneName) MultiZoneClientOpt {
+	return func(m *MultiZoneClient) error {
+		if len(zones) == 0 {
+			return fmt.Errorf("no zones")
+		}
+		m.zones = zones
+		return nil
+	}
+}
+
+// MultiZoneClientOptWithConcurrency returns a MultiZoneClientOpt overriding the
+// maximum number of zones queried concurrently.
+func MultiZoneClientOptWithConcurrency(n int) MultiZoneClientOpt {
+	return func(m *MultiZoneClient) error {
+		if n <= 0 {
+			return fmt.Errorf("invalid concurrency: %d", n)
+		}
+		m.concurrency = n
+		return nil
+	}
+}
+
+// NewMultiZoneClient returns a MultiZoneClient based on the given Client,
+// whose endpoint is used to discover the zones.
+func NewMultiZoneClient(client *Client, opts ...MultiZoneClientOpt) (*MultiZoneClient, error) {
+	m := &MultiZoneClient{
+		client:      client,
+		concurrency: defaultMultiZoneConcurrency,
+	}
+
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, fmt.Errorf("multi-zone client: %w", err)
+		}
+	}
+
+	return m, nil
+}
+
+// Zones returns the zones the MultiZoneClient runs calls in.
+// The zones are discovered once with ListZones, then cached.
+func (m *MultiZoneClient) Zones(ctx context.Context) ([]Zone, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.discovered != nil {
+		return m.discovered, nil
+	}
+
+	resp, err := m.client.ListZones(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Zones: %w", err)
+	}
+
+	zones := []Zone{}
+	for _, zone := range resp.Zones {
+		if m.zones == nil || slices.Contains(m.zones, zone.Name) {
+			zones = append(zones, zone)
+		}
+	}
+	for _, name := range m.zones {
+		if !slices.ContainsFunc(zones, func(z Zone) bool { return z.Name == name }) {
+			return nil, fmt.Errorf("Zones: zone %q not found", name)
+		}
+	}
+	m.discovered = zones
+
+	return zones, nil
+}
+
+// ZoneClient returns a Client bound to the given zone.
+func (m *MultiZoneClient) ZoneClient(ctx context.Context, zone ZoneName) (*Client, error) {
+	zones, err := m.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, z := range zones {
+		if z.Name == zone {
+			return m.client.WithEndpoint(z.APIEndpoint), nil
+		}
+	}
+
+	return nil, fmt.Errorf("ZoneClient: zone %q not found", zone)
+}
+
+// FanOut runs f concurrently in every zone of the MultiZoneClient, and returns
+// the items it listed tagged with their zone, ordered like the zones.
+// If f fails in some zones, the items listed in the other zones are returned
+// along with a ZoneErrors holding the error of each failed zone.
+func FanOut[T any](ctx context.Context, m *MultiZoneClient, f func(ctx context.Context, client *Client) ([]T, error)) ([]ZoneItem[T], error) {
+	zones, err := m.Zones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sem     = make(chan struct{}, m.concurrency)
+		results = make([][]T, len(zones))
+		errs    = ZoneErrors{}
+	)
+	for i, zone := range zones {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs[zone.Name] = ctx.Err()
+				mu.Unlock()
+				return
+			}
+
+			items, err := f(ctx, m.client.WithEndpoint(zone.APIEndpoint))
+			if err != nil {
+				mu.Lock()
+				errs[zone.Name] = err
+				mu.Unlock()
+				return
+			}
+			results[i] = items
+		}()
+	}
+	wg.Wait()
+
+	items := []ZoneItem[T]{}
+	for i, zone := range zones {
+		for _, item := range results[i] {
+			items = append(items, ZoneItem[T]{Zone: zone.Name, Item: item})
+		}
+	}
+
+	if len(errs) > 0 {
+		return items, errs
+	}
+
+	return items, nil
+}
+
+// ListInstances lists the Compute instances of all the zones.
+func (m *MultiZoneClient) ListInstances(ctx context.Context, opts ...ListInstancesOpt) ([]ZoneItem[ListInstancesResponseInstances], error) {
+	return FanOut(ctx, m, func(ctx context.Context, c *Client) ([]ListInstancesResponseInstances, error) {
+		resp, err := c.ListInstances(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Instances, nil
+	})
+}
+
+// ListSKSClusters lists the SKS clusters of all the zones.
+func (m *MultiZoneClient) ListSKSClusters(ctx context.Context) ([]ZoneItem[SKSCluster], error) {
+	return FanOut(ctx, m, func(ctx context.Context, c *Client) ([]SKSCluster, error) {
+		resp, err := c.ListSKSClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.SKSClusters, nil
+	})
+}
+
+// ListLoadBalancers lists the Network Load Balancers of all the zones.
+func (m *MultiZoneClient) ListLoadBalancers(ctx context.Context) ([]ZoneItem[LoadBalancer], error) {
+	return FanOut(ctx, m, func(ctx context.Context, c *Client) ([]LoadBalancer, error) {
+		resp, err := c.ListLoadBalancers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.LoadBalancers, nil
+	})
+}
+
+// ListPrivateNetworks lists the Private Networks of all the zones.
+// Security Groups being global, they are listed with Client.ListSecurityGroups.
+func (m *MultiZoneClient) ListPrivateNetworks(ctx context.Context) ([]ZoneItem[PrivateNetwork], error) {
+	return FanOut(ctx, m, func(ctx context.Context, c *Client) ([]PrivateNetwork, error) {
+		resp, err := c.ListPrivateNetworks(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.PrivateNetworks, nil
+	})
+}
@@ -0,0 +1,48 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiZoneClientListInstances(t *testing.T) {
+	gva := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"instances":[{"id":"i1","name":"a"},{"id":"i2","name":"b"}]}`))
+	}))
+	defer gva.Close()
+
+	fra := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"zone disabled"}`))
+	}))
+	defer fra.Close()
+
+	zones := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"zones":[{"name":"ch-gva-2","api-endpoint":%q},{"name":"de-fra-1","api-endpoint":%q},{"name":"at-vie-1","api-endpoint":%q}]}`,
+			gva.URL, fra.URL, gva.URL)
+	}))
+	defer zones.Close()
+
+	m, err := NewMultiZoneClient(
+		newTestClient(t, zones.URL),
+		MultiZoneClientOptWithZones("ch-gva-2", "de-fra-1"),
+		MultiZoneClientOptWithConcurrency(1),
+	)
+	require.NoError(t, err)
+
+	instances, err := m.ListInstances(context.Background())
+	require.Len(t, instances, 2)
+	require.Equal(t, ZoneName("ch-gva-2"), instances[0].Zone)
+	require.Equal(t, "b", instances[1].Item.Name)
+
+	var zoneErrs ZoneErrors
+	require.ErrorAs(t, err, &zoneErrs)
+	require.Len(t, zoneErrs, 1)
+	require.ErrorIs(t, zoneErrs["de-fra-1"], ErrInvalidRequest)
+	require.ErrorIs(t, err, ErrInvalidRequest)
+}
@@ -6,6 +6,7 @@ package v3
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/http"
 	"net/url"
@@ -41,24 +42,12 @@ func (c Client) ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGr
 		return nil, fmt.Errorf("ListAntiAffinityGroups: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAntiAffinityGroups: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-anti-affinity-groups")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-anti-affinity-groups")
 	if err != nil {
 		return nil, fmt.Errorf("ListAntiAffinityGroups: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-anti-affinity-groups"); err != nil {
 		return nil, fmt.Errorf("ListAntiAffinityGroups: http response: %w", err)
 	}
 
@@ -70,6 +59,23 @@ func (c Client) ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGr
 	return bodyresp, nil
 }
 
+// AllAntiAffinityGroups returns an iterator over the AntiAffinityGroup returned by ListAntiAffinityGroups.
+func (c Client) AllAntiAffinityGroups(ctx context.Context) iter.Seq2[AntiAffinityGroup, error] {
+	return func(yield func(AntiAffinityGroup, error) bool) {
+		resp, err := c.ListAntiAffinityGroups(ctx)
+		if err != nil {
+			yield(AntiAffinityGroup{}, err)
+			return
+		}
+
+		for _, elem := range resp.AntiAffinityGroups {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateAntiAffinityGroupRequest struct {
 	// Anti-affinity Group description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -98,24 +104,12 @@ func (c Client) CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffin
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-anti-affinity-group")
 	if err != nil {
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-anti-affinity-group"); err != nil {
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: http response: %w", err)
 	}
 
@@ -141,24 +135,12 @@ func (c Client) DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-anti-affinity-group")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-anti-affinity-group"); err != nil {
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: http response: %w", err)
 	}
 
@@ -184,24 +166,12 @@ func (c Client) GetAntiAffinityGroup(ctx context.Context, id UUID) (*AntiAffinit
 		return nil, fmt.Errorf("GetAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-anti-affinity-group")
 	if err != nil {
 		return nil, fmt.Errorf("GetAntiAffinityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-anti-affinity-group"); err != nil {
 		return nil, fmt.Errorf("GetAntiAffinityGroup: http response: %w", err)
 	}
 
@@ -231,24 +201,12 @@ func (c Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
 		return nil, fmt.Errorf("ListAPIKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAPIKeys: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-api-keys")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-api-keys")
 	if err != nil {
 		return nil, fmt.Errorf("ListAPIKeys: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-api-keys"); err != nil {
 		return nil, fmt.Errorf("ListAPIKeys: http response: %w", err)
 	}
 
@@ -260,6 +218,23 @@ func (c Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
 	return bodyresp, nil
 }
 
+// AllAPIKeys returns an iterator over the IAMAPIKey returned by ListAPIKeys.
+func (c Client) AllAPIKeys(ctx context.Context) iter.Seq2[IAMAPIKey, error] {
+	return func(yield func(IAMAPIKey, error) bool) {
+		resp, err := c.ListAPIKeys(ctx)
+		if err != nil {
+			yield(IAMAPIKey{}, err)
+			return
+		}
+
+		for _, elem := range resp.APIKeys {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateAPIKeyRequest struct {
 	// IAM API Key Name
 	Name string `json:"name" validate:"required,gte=1,lte=255"`
@@ -288,24 +263,12 @@ func (c Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAM
 		return nil, fmt.Errorf("CreateAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-api-key")
 	if err != nil {
 		return nil, fmt.Errorf("CreateAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-api-key"); err != nil {
 		return nil, fmt.Errorf("CreateAPIKey: http response: %w", err)
 	}
 
@@ -331,24 +294,12 @@ func (c Client) DeleteAPIKey(ctx context.Context, id string) (*Operation, error)
 		return nil, fmt.Errorf("DeleteAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-api-key")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-api-key"); err != nil {
 		return nil, fmt.Errorf("DeleteAPIKey: http response: %w", err)
 	}
 
@@ -374,24 +325,12 @@ func (c Client) GetAPIKey(ctx context.Context, id string) (*IAMAPIKey, error) {
 		return nil, fmt.Errorf("GetAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-api-key")
 	if err != nil {
 		return nil, fmt.Errorf("GetAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-api-key"); err != nil {
 		return nil, fmt.Errorf("GetAPIKey: http response: %w", err)
 	}
 
@@ -448,24 +387,12 @@ func (c Client) ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockSt
 		return nil, fmt.Errorf("ListBlockStorageVolumes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageVolumes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-block-storage-volumes")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-block-storage-volumes")
 	if err != nil {
 		return nil, fmt.Errorf("ListBlockStorageVolumes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-block-storage-volumes"); err != nil {
 		return nil, fmt.Errorf("ListBlockStorageVolumes: http response: %w", err)
 	}
 
@@ -477,6 +404,23 @@ func (c Client) ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockSt
 	return bodyresp, nil
 }
 
+// AllBlockStorageVolumes returns an iterator over the BlockStorageVolume returned by ListBlockStorageVolumes.
+func (c Client) AllBlockStorageVolumes(ctx context.Context, opts ...ListBlockStorageVolumesOpt) iter.Seq2[BlockStorageVolume, error] {
+	return func(yield func(BlockStorageVolume, error) bool) {
+		resp, err := c.ListBlockStorageVolumes(ctx, opts...)
+		if err != nil {
+			yield(BlockStorageVolume{}, err)
+			return
+		}
+
+		for _, elem := range resp.BlockStorageVolumes {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateBlockStorageVolumeRequest struct {
 	// Target block storage snapshot
 	BlockStorageSnapshot *BlockStorageSnapshotTarget `json:"block-storage-snapshot,omitempty"`
@@ -509,24 +453,12 @@ func (c Client) CreateBlockStorageVolume(ctx context.Context, req CreateBlockSto
 		return nil, fmt.Errorf("CreateBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-block-storage-volume")
 	if err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-block-storage-volume"); err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageVolume: http response: %w", err)
 	}
 
@@ -567,24 +499,12 @@ func (c Client) ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorag
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageSnapshots: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-block-storage-snapshots")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-block-storage-snapshots")
 	if err != nil {
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-block-storage-snapshots"); err != nil {
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: http response: %w", err)
 	}
 
@@ -596,6 +516,23 @@ func (c Client) ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorag
 	return bodyresp, nil
 }
 
+// AllBlockStorageSnapshots returns an iterator over the BlockStorageSnapshot returned by ListBlockStorageSnapshots.
+func (c Client) AllBlockStorageSnapshots(ctx context.Context) iter.Seq2[BlockStorageSnapshot, error] {
+	return func(yield func(BlockStorageSnapshot, error) bool) {
+		resp, err := c.ListBlockStorageSnapshots(ctx)
+		if err != nil {
+			yield(BlockStorageSnapshot{}, err)
+			return
+		}
+
+		for _, elem := range resp.BlockStorageSnapshots {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Delete a block storage snapshot, data will be unrecoverable
 func (c Client) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage-snapshot/%v", id)
@@ -610,24 +547,12 @@ func (c Client) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Opera
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-block-storage-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-block-storage-snapshot"); err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: http response: %w", err)
 	}
 
@@ -653,24 +578,12 @@ func (c Client) GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockSto
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-block-storage-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-block-storage-snapshot"); err != nil {
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: http response: %w", err)
 	}
 
@@ -682,6 +595,30 @@ func (c Client) GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockSto
 	return bodyresp, nil
 }
 
+// WaitBlockStorageSnapshotState waits for the BlockStorageSnapshot to reach the given state, polling GetBlockStorageSnapshot.
+// The wait fails if the BlockStorageSnapshot reaches the error state.
+func (c Client) WaitBlockStorageSnapshotState(ctx context.Context, id UUID, state BlockStorageSnapshotState, opts ...WaitOpt) (*BlockStorageSnapshot, error) {
+	var resource *BlockStorageSnapshot
+	err := c.poll(ctx, "WaitBlockStorageSnapshotState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetBlockStorageSnapshot(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == BlockStorageSnapshotStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitBlockStorageSnapshotState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitBlockStorageSnapshotState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateBlockStorageSnapshotRequest struct {
 	Labels Labels `json:"labels"`
 	// Snapshot name
@@ -709,24 +646,12 @@ func (c Client) UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req Upd
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-block-storage-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-block-storage-snapshot"); err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: http response: %w", err)
 	}
 
@@ -752,24 +677,12 @@ func (c Client) DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-block-storage-volume")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-block-storage-volume"); err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: http response: %w", err)
 	}
 
@@ -795,24 +708,12 @@ func (c Client) GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStora
 		return nil, fmt.Errorf("GetBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-block-storage-volume")
 	if err != nil {
 		return nil, fmt.Errorf("GetBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-block-storage-volume"); err != nil {
 		return nil, fmt.Errorf("GetBlockStorageVolume: http response: %w", err)
 	}
 
@@ -824,6 +725,30 @@ func (c Client) GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStora
 	return bodyresp, nil
 }
 
+// WaitBlockStorageVolumeState waits for the BlockStorageVolume to reach the given state, polling GetBlockStorageVolume.
+// The wait fails if the BlockStorageVolume reaches the error state.
+func (c Client) WaitBlockStorageVolumeState(ctx context.Context, id UUID, state BlockStorageVolumeState, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	var resource *BlockStorageVolume
+	err := c.poll(ctx, "WaitBlockStorageVolumeState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetBlockStorageVolume(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == BlockStorageVolumeStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitBlockStorageVolumeState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitBlockStorageVolumeState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateBlockStorageVolumeRequest struct {
 	Labels Labels `json:"labels"`
 	// Volume name
@@ -851,24 +776,12 @@ func (c Client) UpdateBlockStorageVolume(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-block-storage-volume")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-block-storage-volume"); err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: http response: %w", err)
 	}
 
@@ -906,24 +819,12 @@ func (c Client) AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID,
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-block-storage-volume-to-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "attach-block-storage-volume-to-instance")
 	if err != nil {
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "attach-block-storage-volume-to-instance"); err != nil {
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: http response: %w", err)
 	}
 
@@ -962,24 +863,12 @@ func (c Client) CreateBlockStorageSnapshot(ctx context.Context, id UUID, req Cre
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-block-storage-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-block-storage-snapshot"); err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: http response: %w", err)
 	}
 
@@ -1005,24 +894,12 @@ func (c Client) DetachBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DetachBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "detach-block-storage-volume")
 	if err != nil {
 		return nil, fmt.Errorf("DetachBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "detach-block-storage-volume"); err != nil {
 		return nil, fmt.Errorf("DetachBlockStorageVolume: http response: %w", err)
 	}
 
@@ -1060,24 +937,12 @@ func (c Client) ResizeBlockStorageVolume(ctx context.Context, id UUID, req Resiz
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResizeBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "resize-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "resize-block-storage-volume")
 	if err != nil {
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "resize-block-storage-volume"); err != nil {
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: http response: %w", err)
 	}
 
@@ -1109,24 +974,12 @@ func (c Client) GetConsoleProxyURL(ctx context.Context, id UUID) (*GetConsolePro
 		return nil, fmt.Errorf("GetConsoleProxyURL: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetConsoleProxyURL: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-console-proxy-url")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-console-proxy-url")
 	if err != nil {
 		return nil, fmt.Errorf("GetConsoleProxyURL: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-console-proxy-url"); err != nil {
 		return nil, fmt.Errorf("GetConsoleProxyURL: http response: %w", err)
 	}
 
@@ -1156,24 +1009,12 @@ func (c Client) GetDBAASCACertificate(ctx context.Context) (*GetDBAASCACertifica
 		return nil, fmt.Errorf("GetDBAASCACertificate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASCACertificate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-ca-certificate")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-ca-certificate")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASCACertificate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-ca-certificate"); err != nil {
 		return nil, fmt.Errorf("GetDBAASCACertificate: http response: %w", err)
 	}
 
@@ -1199,24 +1040,12 @@ func (c Client) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Op
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service-grafana")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service-grafana"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: http response: %w", err)
 	}
 
@@ -1242,24 +1071,12 @@ func (c Client) GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-grafana")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-grafana"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: http response: %w", err)
 	}
 
@@ -1271,6 +1088,29 @@ func (c Client) GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAAS
 	return bodyresp, nil
 }
 
+// WaitDBAASServiceGrafanaState waits for the DBAASServiceGrafana to reach the given state, polling GetDBAASServiceGrafana.
+func (c Client) WaitDBAASServiceGrafanaState(ctx context.Context, name string, state EnumServiceState, opts ...WaitOpt) (*DBAASServiceGrafana, error) {
+	var resource *DBAASServiceGrafana
+	err := c.poll(ctx, "WaitDBAASServiceGrafanaState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetDBAASServiceGrafana(ctx, name)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServiceGrafanaState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitDBAASServiceGrafanaState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type CreateDBAASServiceGrafanaRequestMaintenanceDow string
 
 const (
@@ -1327,24 +1167,12 @@ func (c Client) CreateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-service-grafana")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-service-grafana"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: http response: %w", err)
 	}
 
@@ -1411,24 +1239,12 @@ func (c Client) UpdateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-service-grafana")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-service-grafana"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: http response: %w", err)
 	}
 
@@ -1454,24 +1270,12 @@ func (c Client) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-grafana-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-dbaas-grafana-maintenance")
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-dbaas-grafana-maintenance"); err != nil {
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: http response: %w", err)
 	}
 
@@ -1508,24 +1312,12 @@ func (c Client) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-grafana-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-dbaas-grafana-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-dbaas-grafana-user-password"); err != nil {
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: http response: %w", err)
 	}
 
@@ -1551,24 +1343,12 @@ func (c Client) RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-grafana-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-dbaas-grafana-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-dbaas-grafana-user-password"); err != nil {
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: http response: %w", err)
 	}
 
@@ -1609,24 +1389,12 @@ func (c Client) CreateDBAASIntegration(ctx context.Context, req CreateDBAASInteg
 		return nil, fmt.Errorf("CreateDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-integration")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-integration"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASIntegration: http response: %w", err)
 	}
 
@@ -1665,24 +1433,12 @@ func (c Client) ListDBAASIntegrationSettings(ctx context.Context, integrationTyp
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-integration-settings")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-dbaas-integration-settings")
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-dbaas-integration-settings"); err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: http response: %w", err)
 	}
 
@@ -1712,24 +1468,12 @@ func (c Client) ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegr
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-integration-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-dbaas-integration-types")
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-dbaas-integration-types"); err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: http response: %w", err)
 	}
 
@@ -1741,6 +1485,23 @@ func (c Client) ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegr
 	return bodyresp, nil
 }
 
+// AllDBAASIntegrationTypes returns an iterator over the DBAASIntegrationType returned by ListDBAASIntegrationTypes.
+func (c Client) AllDBAASIntegrationTypes(ctx context.Context) iter.Seq2[DBAASIntegrationType, error] {
+	return func(yield func(DBAASIntegrationType, error) bool) {
+		resp, err := c.ListDBAASIntegrationTypes(ctx)
+		if err != nil {
+			yield(DBAASIntegrationType{}, err)
+			return
+		}
+
+		for _, elem := range resp.DBAASIntegrationTypes {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Delete a DBaaS Integration
 func (c Client) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-integration/%v", id)
@@ -1755,24 +1516,12 @@ func (c Client) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-integration")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-integration"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASIntegration: http response: %w", err)
 	}
 
@@ -1798,24 +1547,12 @@ func (c Client) GetDBAASIntegration(ctx context.Context, id UUID) (*DBAASIntegra
 		return nil, fmt.Errorf("GetDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-integration")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-integration"); err != nil {
 		return nil, fmt.Errorf("GetDBAASIntegration: http response: %w", err)
 	}
 
@@ -1853,24 +1590,12 @@ func (c Client) UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateD
 		return nil, fmt.Errorf("UpdateDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-integration")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-integration"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASIntegration: http response: %w", err)
 	}
 
@@ -1896,24 +1621,12 @@ func (c Client) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service-kafka")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service-kafka"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: http response: %w", err)
 	}
 
@@ -1939,33 +1652,44 @@ func (c Client) GetDBAASServiceKafka(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: sign request: %w", err)
+	response, err := c.do(ctx, request, "get-dbaas-service-kafka")
+	if err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-kafka")
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-kafka"); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceKafka: http response: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: http client do: %w", err)
+	bodyresp := &DBAASServiceKafka{}
+	if err := prepareJSONResponse(response, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceKafka: prepare Json response: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+	return bodyresp, nil
+}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: http response: %w", err)
+// WaitDBAASServiceKafkaState waits for the DBAASServiceKafka to reach the given state, polling GetDBAASServiceKafka.
+func (c Client) WaitDBAASServiceKafkaState(ctx context.Context, name string, state EnumServiceState, opts ...WaitOpt) (*DBAASServiceKafka, error) {
+	var resource *DBAASServiceKafka
+	err := c.poll(ctx, "WaitDBAASServiceKafkaState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetDBAASServiceKafka(ctx, name)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServiceKafkaState: %w", err)
 	}
 
-	bodyresp := &DBAASServiceKafka{}
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: prepare Json response: %w", err)
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitDBAASServiceKafkaState: unexpected state %q", resource.State)
 	}
 
-	return bodyresp, nil
+	return resource, nil
 }
 
 // Kafka authentication methods
@@ -2047,24 +1771,12 @@ func (c Client) CreateDBAASServiceKafka(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-service-kafka")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-service-kafka"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: http response: %w", err)
 	}
 
@@ -2155,24 +1867,12 @@ func (c Client) UpdateDBAASServiceKafka(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-service-kafka")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-service-kafka"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: http response: %w", err)
 	}
 
@@ -2198,24 +1898,12 @@ func (c Client) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-kafka-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-kafka-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-kafka-acl-config"); err != nil {
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: http response: %w", err)
 	}
 
@@ -2241,24 +1929,12 @@ func (c Client) StartDBAASKafkaMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-kafka-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-dbaas-kafka-maintenance")
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-dbaas-kafka-maintenance"); err != nil {
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: http response: %w", err)
 	}
 
@@ -2291,24 +1967,12 @@ func (c Client) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-schema-registry-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-kafka-schema-registry-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-kafka-schema-registry-acl-config"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: http response: %w", err)
 	}
 
@@ -2334,24 +1998,12 @@ func (c Client) DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-schema-registry-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-kafka-schema-registry-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-kafka-schema-registry-acl-config"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: http response: %w", err)
 	}
 
@@ -2384,24 +2036,12 @@ func (c Client) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-topic-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-kafka-topic-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-kafka-topic-acl-config"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: http response: %w", err)
 	}
 
@@ -2427,24 +2067,12 @@ func (c Client) DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-topic-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-kafka-topic-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-kafka-topic-acl-config"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: http response: %w", err)
 	}
 
@@ -2481,24 +2109,12 @@ func (c Client) CreateDBAASKafkaUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-kafka-user")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-kafka-user"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: http response: %w", err)
 	}
 
@@ -2524,24 +2140,12 @@ func (c Client) DeleteDBAASKafkaUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-kafka-user")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-kafka-user"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: http response: %w", err)
 	}
 
@@ -2578,24 +2182,12 @@ func (c Client) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-kafka-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-dbaas-kafka-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-dbaas-kafka-user-password"); err != nil {
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: http response: %w", err)
 	}
 
@@ -2621,24 +2213,12 @@ func (c Client) RevealDBAASKafkaUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-kafka-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-dbaas-kafka-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-dbaas-kafka-user-password"); err != nil {
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: http response: %w", err)
 	}
 
@@ -2664,24 +2244,12 @@ func (c Client) GetDBAASMigrationStatus(ctx context.Context, name string) (*DBAA
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASMigrationStatus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-migration-status")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-migration-status")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-migration-status"); err != nil {
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: http response: %w", err)
 	}
 
@@ -2707,24 +2275,12 @@ func (c Client) DeleteDBAASServiceMysql(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service-mysql")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service-mysql"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: http response: %w", err)
 	}
 
@@ -2750,24 +2306,12 @@ func (c Client) GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-mysql")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-mysql"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMysql: http response: %w", err)
 	}
 
@@ -2779,6 +2323,29 @@ func (c Client) GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASSe
 	return bodyresp, nil
 }
 
+// WaitDBAASServiceMysqlState waits for the DBAASServiceMysql to reach the given state, polling GetDBAASServiceMysql.
+func (c Client) WaitDBAASServiceMysqlState(ctx context.Context, name string, state EnumServiceState, opts ...WaitOpt) (*DBAASServiceMysql, error) {
+	var resource *DBAASServiceMysql
+	err := c.poll(ctx, "WaitDBAASServiceMysqlState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetDBAASServiceMysql(ctx, name)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServiceMysqlState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitDBAASServiceMysqlState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type CreateDBAASServiceMysqlRequestBackupSchedule struct {
 	// The hour of day (in UTC) when backup for the service is started. New backup is only started if previous backup has already completed.
 	BackupHour int64 `json:"backup-hour,omitempty" validate:"omitempty,gte=0,lte=23"`
@@ -2891,24 +2458,12 @@ func (c Client) CreateDBAASServiceMysql(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-service-mysql")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-service-mysql"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: http response: %w", err)
 	}
 
@@ -3006,24 +2561,12 @@ func (c Client) UpdateDBAASServiceMysql(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-service-mysql")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-service-mysql"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: http response: %w", err)
 	}
 
@@ -3049,24 +2592,12 @@ func (c Client) StartDBAASMysqlMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-mysql-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-dbaas-mysql-maintenance")
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-dbaas-mysql-maintenance"); err != nil {
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: http response: %w", err)
 	}
 
@@ -3092,24 +2623,12 @@ func (c Client) StopDBAASMysqlMigration(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASMysqlMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-mysql-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "stop-dbaas-mysql-migration")
 	if err != nil {
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "stop-dbaas-mysql-migration"); err != nil {
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: http response: %w", err)
 	}
 
@@ -3146,24 +2665,12 @@ func (c Client) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-mysql-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-mysql-database")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-mysql-database"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: http response: %w", err)
 	}
 
@@ -3189,24 +2696,12 @@ func (c Client) DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-mysql-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-mysql-database")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-mysql-database"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: http response: %w", err)
 	}
 
@@ -3244,24 +2739,12 @@ func (c Client) CreateDBAASMysqlUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-mysql-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-mysql-user")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-mysql-user"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: http response: %w", err)
 	}
 
@@ -3287,24 +2770,12 @@ func (c Client) DeleteDBAASMysqlUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-mysql-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-mysql-user")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-mysql-user"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: http response: %w", err)
 	}
 
@@ -3342,24 +2813,12 @@ func (c Client) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-mysql-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-dbaas-mysql-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-dbaas-mysql-user-password"); err != nil {
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: http response: %w", err)
 	}
 
@@ -3385,24 +2844,12 @@ func (c Client) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-mysql-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-dbaas-mysql-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-dbaas-mysql-user-password"); err != nil {
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: http response: %w", err)
 	}
 
@@ -3428,24 +2875,12 @@ func (c Client) DeleteDBAASServiceOpensearch(ctx context.Context, name string) (
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service-opensearch")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service-opensearch"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: http response: %w", err)
 	}
 
@@ -3471,24 +2906,12 @@ func (c Client) GetDBAASServiceOpensearch(ctx context.Context, name string) (*DB
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-opensearch")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-opensearch"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: http response: %w", err)
 	}
 
@@ -3500,6 +2923,29 @@ func (c Client) GetDBAASServiceOpensearch(ctx context.Context, name string) (*DB
 	return bodyresp, nil
 }
 
+// WaitDBAASServiceOpensearchState waits for the DBAASServiceOpensearch to reach the given state, polling GetDBAASServiceOpensearch.
+func (c Client) WaitDBAASServiceOpensearchState(ctx context.Context, name string, state EnumServiceState, opts ...WaitOpt) (*DBAASServiceOpensearch, error) {
+	var resource *DBAASServiceOpensearch
+	err := c.poll(ctx, "WaitDBAASServiceOpensearchState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetDBAASServiceOpensearch(ctx, name)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServiceOpensearchState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitDBAASServiceOpensearchState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type CreateDBAASServiceOpensearchRequestIndexPatternsSortingAlgorithm string
 
 const (
@@ -3606,24 +3052,12 @@ func (c Client) CreateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-service-opensearch")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-service-opensearch"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: http response: %w", err)
 	}
 
@@ -3738,24 +3172,12 @@ func (c Client) UpdateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-service-opensearch")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-service-opensearch"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: http response: %w", err)
 	}
 
@@ -3781,24 +3203,12 @@ func (c Client) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-opensearch-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-opensearch-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-opensearch-acl-config"); err != nil {
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: http response: %w", err)
 	}
 
@@ -3831,24 +3241,12 @@ func (c Client) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-opensearch-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-opensearch-acl-config")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-opensearch-acl-config"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: http response: %w", err)
 	}
 
@@ -3874,24 +3272,12 @@ func (c Client) StartDBAASOpensearchMaintenance(ctx context.Context, name string
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-opensearch-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-dbaas-opensearch-maintenance")
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-dbaas-opensearch-maintenance"); err != nil {
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: http response: %w", err)
 	}
 
@@ -3928,24 +3314,12 @@ func (c Client) CreateDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASOpensearchUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-opensearch-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-opensearch-user")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-opensearch-user"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: http response: %w", err)
 	}
 
@@ -3971,24 +3345,12 @@ func (c Client) DeleteDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-opensearch-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-opensearch-user")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-opensearch-user"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: http response: %w", err)
 	}
 
@@ -4025,24 +3387,12 @@ func (c Client) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceNam
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-opensearch-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-dbaas-opensearch-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-dbaas-opensearch-user-password"); err != nil {
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: http response: %w", err)
 	}
 
@@ -4068,24 +3418,12 @@ func (c Client) RevealDBAASOpensearchUserPassword(ctx context.Context, serviceNa
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-opensearch-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-dbaas-opensearch-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-dbaas-opensearch-user-password"); err != nil {
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: http response: %w", err)
 	}
 
@@ -4111,24 +3449,12 @@ func (c Client) DeleteDBAASServicePG(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("DeleteDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service-pg")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service-pg"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServicePG: http response: %w", err)
 	}
 
@@ -4154,33 +3480,44 @@ func (c Client) GetDBAASServicePG(ctx context.Context, name string) (*DBAASServi
 		return nil, fmt.Errorf("GetDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: sign request: %w", err)
+	response, err := c.do(ctx, request, "get-dbaas-service-pg")
+	if err != nil {
+		return nil, fmt.Errorf("GetDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-pg")
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-pg"); err != nil {
+		return nil, fmt.Errorf("GetDBAASServicePG: http response: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: http client do: %w", err)
+	bodyresp := &DBAASServicePG{}
+	if err := prepareJSONResponse(response, bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServicePG: prepare Json response: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
+	return bodyresp, nil
+}
+
+// WaitDBAASServicePGState waits for the DBAASServicePG to reach the given state, polling GetDBAASServicePG.
+func (c Client) WaitDBAASServicePGState(ctx context.Context, name string, state EnumServiceState, opts ...WaitOpt) (*DBAASServicePG, error) {
+	var resource *DBAASServicePG
+	err := c.poll(ctx, "WaitDBAASServicePGState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetDBAASServicePG(ctx, name)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: http response: %w", err)
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServicePGState: %w", err)
 	}
 
-	bodyresp := &DBAASServicePG{}
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: prepare Json response: %w", err)
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitDBAASServicePGState: unexpected state %q", resource.State)
 	}
 
-	return bodyresp, nil
+	return resource, nil
 }
 
 type CreateDBAASServicePGRequestBackupSchedule struct {
@@ -4304,24 +3641,12 @@ func (c Client) CreateDBAASServicePG(ctx context.Context, name string, req Creat
 		return nil, fmt.Errorf("CreateDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-service-pg")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-service-pg"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServicePG: http response: %w", err)
 	}
 
@@ -4431,24 +3756,12 @@ func (c Client) UpdateDBAASServicePG(ctx context.Context, name string, req Updat
 		return nil, fmt.Errorf("UpdateDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-service-pg")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-service-pg"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServicePG: http response: %w", err)
 	}
 
@@ -4474,24 +3787,12 @@ func (c Client) StartDBAASPGMaintenance(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASPGMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-pg-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-dbaas-pg-maintenance")
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-dbaas-pg-maintenance"); err != nil {
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: http response: %w", err)
 	}
 
@@ -4517,24 +3818,12 @@ func (c Client) StopDBAASPGMigration(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("StopDBAASPGMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASPGMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-pg-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "stop-dbaas-pg-migration")
 	if err != nil {
 		return nil, fmt.Errorf("StopDBAASPGMigration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "stop-dbaas-pg-migration"); err != nil {
 		return nil, fmt.Errorf("StopDBAASPGMigration: http response: %w", err)
 	}
 
@@ -4575,24 +3864,12 @@ func (c Client) CreateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-pg-connection-pool")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-pg-connection-pool"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: http response: %w", err)
 	}
 
@@ -4618,24 +3895,12 @@ func (c Client) DeleteDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-pg-connection-pool")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-pg-connection-pool"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: http response: %w", err)
 	}
 
@@ -4675,24 +3940,12 @@ func (c Client) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-pg-connection-pool")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-pg-connection-pool"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: http response: %w", err)
 	}
 
@@ -4733,24 +3986,12 @@ func (c Client) CreateDBAASPGDatabase(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-pg-database")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-pg-database"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: http response: %w", err)
 	}
 
@@ -4776,24 +4017,12 @@ func (c Client) DeleteDBAASPGDatabase(ctx context.Context, serviceName string, d
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-pg-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-pg-database")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-pg-database"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: http response: %w", err)
 	}
 
@@ -4831,24 +4060,12 @@ func (c Client) CreateDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-postgres-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-postgres-user")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-postgres-user"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: http response: %w", err)
 	}
 
@@ -4874,24 +4091,12 @@ func (c Client) DeleteDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPostgresUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-postgres-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-postgres-user")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-postgres-user"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: http response: %w", err)
 	}
 
@@ -4928,24 +4133,12 @@ func (c Client) UpdateDBAASPostgresAllowReplication(ctx context.Context, service
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-postgres-allow-replication")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-postgres-allow-replication")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-postgres-allow-replication"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: http response: %w", err)
 	}
 
@@ -4982,24 +4175,12 @@ func (c Client) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-postgres-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-dbaas-postgres-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-dbaas-postgres-user-password"); err != nil {
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: http response: %w", err)
 	}
 
@@ -5025,24 +4206,12 @@ func (c Client) RevealDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-postgres-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-dbaas-postgres-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-dbaas-postgres-user-password"); err != nil {
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: http response: %w", err)
 	}
 
@@ -5079,24 +4248,12 @@ func (c Client) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, r
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-upgrade-check")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-pg-upgrade-check")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-pg-upgrade-check"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: http response: %w", err)
 	}
 
@@ -5122,24 +4279,12 @@ func (c Client) DeleteDBAASServiceRedis(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceRedis: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceRedis: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-redis")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service-redis")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceRedis: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service-redis"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceRedis: http response: %w", err)
 	}
 
@@ -5165,24 +4310,12 @@ func (c Client) GetDBAASServiceRedis(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceRedis: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceRedis: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-redis")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-redis")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceRedis: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-redis"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceRedis: http response: %w", err)
 	}
 
@@ -5194,6 +4327,29 @@ func (c Client) GetDBAASServiceRedis(ctx context.Context, name string) (*DBAASSe
 	return bodyresp, nil
 }
 
+// WaitDBAASServiceRedisState waits for the DBAASServiceRedis to reach the given state, polling GetDBAASServiceRedis.
+func (c Client) WaitDBAASServiceRedisState(ctx context.Context, name string, state EnumServiceState, opts ...WaitOpt) (*DBAASServiceRedis, error) {
+	var resource *DBAASServiceRedis
+	err := c.poll(ctx, "WaitDBAASServiceRedisState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetDBAASServiceRedis(ctx, name)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServiceRedisState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitDBAASServiceRedisState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type CreateDBAASServiceRedisRequestMaintenanceDow string
 
 const (
@@ -5273,24 +4429,12 @@ func (c Client) CreateDBAASServiceRedis(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceRedis: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceRedis: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-redis")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-service-redis")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceRedis: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-service-redis"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceRedis: http response: %w", err)
 	}
 
@@ -5378,24 +4522,12 @@ func (c Client) UpdateDBAASServiceRedis(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceRedis: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceRedis: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-redis")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dbaas-service-redis")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceRedis: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dbaas-service-redis"); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceRedis: http response: %w", err)
 	}
 
@@ -5421,24 +4553,12 @@ func (c Client) StartDBAASRedisMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASRedisMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASRedisMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-redis-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-dbaas-redis-maintenance")
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASRedisMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-dbaas-redis-maintenance"); err != nil {
 		return nil, fmt.Errorf("StartDBAASRedisMaintenance: http response: %w", err)
 	}
 
@@ -5464,24 +4584,12 @@ func (c Client) StopDBAASRedisMigration(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StopDBAASRedisMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASRedisMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-redis-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "stop-dbaas-redis-migration")
 	if err != nil {
 		return nil, fmt.Errorf("StopDBAASRedisMigration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "stop-dbaas-redis-migration"); err != nil {
 		return nil, fmt.Errorf("StopDBAASRedisMigration: http response: %w", err)
 	}
 
@@ -5518,24 +4626,12 @@ func (c Client) CreateDBAASRedisUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASRedisUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASRedisUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-redis-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-redis-user")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASRedisUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-redis-user"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASRedisUser: http response: %w", err)
 	}
 
@@ -5561,24 +4657,12 @@ func (c Client) DeleteDBAASRedisUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASRedisUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASRedisUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-redis-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-redis-user")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASRedisUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-redis-user"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASRedisUser: http response: %w", err)
 	}
 
@@ -5615,24 +4699,12 @@ func (c Client) ResetDBAASRedisUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASRedisUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASRedisUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-redis-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-dbaas-redis-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASRedisUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-dbaas-redis-user-password"); err != nil {
 		return nil, fmt.Errorf("ResetDBAASRedisUserPassword: http response: %w", err)
 	}
 
@@ -5658,24 +4730,12 @@ func (c Client) RevealDBAASRedisUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASRedisUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASRedisUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-redis-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-dbaas-redis-user-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASRedisUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-dbaas-redis-user-password"); err != nil {
 		return nil, fmt.Errorf("RevealDBAASRedisUserPassword: http response: %w", err)
 	}
 
@@ -5705,24 +4765,12 @@ func (c Client) ListDBAASServices(ctx context.Context) (*ListDBAASServicesRespon
 		return nil, fmt.Errorf("ListDBAASServices: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASServices: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-services")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-dbaas-services")
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASServices: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-dbaas-services"); err != nil {
 		return nil, fmt.Errorf("ListDBAASServices: http response: %w", err)
 	}
 
@@ -5734,6 +4782,23 @@ func (c Client) ListDBAASServices(ctx context.Context) (*ListDBAASServicesRespon
 	return bodyresp, nil
 }
 
+// AllDBAASServices returns an iterator over the DBAASServiceCommon returned by ListDBAASServices.
+func (c Client) AllDBAASServices(ctx context.Context) iter.Seq2[DBAASServiceCommon, error] {
+	return func(yield func(DBAASServiceCommon, error) bool) {
+		resp, err := c.ListDBAASServices(ctx)
+		if err != nil {
+			yield(DBAASServiceCommon{}, err)
+			return
+		}
+
+		for _, elem := range resp.DBAASServices {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GetDBAASServiceLogsRequest struct {
 	// How many log entries to receive at most, up to 500 (default: 100)
 	Limit int64 `json:"limit,omitempty" validate:"omitempty,gte=1,lte=500"`
@@ -5763,24 +4828,12 @@ func (c Client) GetDBAASServiceLogs(ctx context.Context, serviceName string, req
 		return nil, fmt.Errorf("GetDBAASServiceLogs: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceLogs: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-logs")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-logs")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceLogs: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-logs"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceLogs: http response: %w", err)
 	}
 
@@ -5792,6 +4845,31 @@ func (c Client) GetDBAASServiceLogs(ctx context.Context, serviceName string, req
 	return bodyresp, nil
 }
 
+// AllDBAASServiceLogs returns an iterator over the DBAASServiceLogsLogs returned by GetDBAASServiceLogs,
+// following the offsets returned by the API until no more entries are returned.
+func (c Client) AllDBAASServiceLogs(ctx context.Context, serviceName string, req GetDBAASServiceLogsRequest) iter.Seq2[DBAASServiceLogsLogs, error] {
+	return func(yield func(DBAASServiceLogsLogs, error) bool) {
+		for {
+			resp, err := c.GetDBAASServiceLogs(ctx, serviceName, req)
+			if err != nil {
+				yield(DBAASServiceLogsLogs{}, err)
+				return
+			}
+
+			for _, elem := range resp.Logs {
+				if !yield(elem, nil) {
+					return
+				}
+			}
+
+			if len(resp.Logs) == 0 || resp.Offset == "" || resp.Offset == req.Offset {
+				return
+			}
+			req.Offset = resp.Offset
+		}
+	}
+}
+
 type GetDBAASServiceMetricsResponse struct {
 	Metrics map[string]any `json:"metrics,omitempty"`
 }
@@ -5832,24 +4910,12 @@ func (c Client) GetDBAASServiceMetrics(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMetrics: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-metrics")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-metrics")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-metrics"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: http response: %w", err)
 	}
 
@@ -5879,24 +4945,12 @@ func (c Client) ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTyp
 		return nil, fmt.Errorf("ListDBAASServiceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASServiceTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-service-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-dbaas-service-types")
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASServiceTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-dbaas-service-types"); err != nil {
 		return nil, fmt.Errorf("ListDBAASServiceTypes: http response: %w", err)
 	}
 
@@ -5908,6 +4962,23 @@ func (c Client) ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTyp
 	return bodyresp, nil
 }
 
+// AllDBAASServiceTypes returns an iterator over the DBAASServiceType returned by ListDBAASServiceTypes.
+func (c Client) AllDBAASServiceTypes(ctx context.Context) iter.Seq2[DBAASServiceType, error] {
+	return func(yield func(DBAASServiceType, error) bool) {
+		resp, err := c.ListDBAASServiceTypes(ctx)
+		if err != nil {
+			yield(DBAASServiceType{}, err)
+			return
+		}
+
+		for _, elem := range resp.DBAASServiceTypes {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Get a DBaaS service type
 func (c Client) GetDBAASServiceType(ctx context.Context, serviceTypeName string) (*DBAASServiceType, error) {
 	path := fmt.Sprintf("/dbaas-service-type/%v", serviceTypeName)
@@ -5922,24 +4993,12 @@ func (c Client) GetDBAASServiceType(ctx context.Context, serviceTypeName string)
 		return nil, fmt.Errorf("GetDBAASServiceType: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceType: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-type")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-service-type")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceType: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-service-type"); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceType: http response: %w", err)
 	}
 
@@ -5965,24 +5024,12 @@ func (c Client) DeleteDBAASService(ctx context.Context, name string) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dbaas-service")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dbaas-service"); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASService: http response: %w", err)
 	}
 
@@ -6025,24 +5072,12 @@ func (c Client) GetDBAASSettingsGrafana(ctx context.Context) (*GetDBAASSettingsG
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-settings-grafana")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-settings-grafana"); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: http response: %w", err)
 	}
 
@@ -6115,24 +5150,12 @@ func (c Client) GetDBAASSettingsKafka(ctx context.Context) (*GetDBAASSettingsKaf
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-settings-kafka")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-settings-kafka"); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: http response: %w", err)
 	}
 
@@ -6175,24 +5198,12 @@ func (c Client) GetDBAASSettingsMysql(ctx context.Context) (*GetDBAASSettingsMys
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-settings-mysql")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-settings-mysql"); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: http response: %w", err)
 	}
 
@@ -6235,24 +5246,12 @@ func (c Client) GetDBAASSettingsOpensearch(ctx context.Context) (*GetDBAASSettin
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-settings-opensearch")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-settings-opensearch"); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: http response: %w", err)
 	}
 
@@ -6325,24 +5324,12 @@ func (c Client) GetDBAASSettingsPG(ctx context.Context) (*GetDBAASSettingsPGResp
 		return nil, fmt.Errorf("GetDBAASSettingsPG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsPG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-settings-pg")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsPG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-settings-pg"); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsPG: http response: %w", err)
 	}
 
@@ -6382,27 +5369,15 @@ func (c Client) GetDBAASSettingsRedis(ctx context.Context) (*GetDBAASSettingsRed
 	request.Header.Add("User-Agent", UserAgent)
 
 	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsRedis: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsRedis: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-redis")
+		return nil, fmt.Errorf("GetDBAASSettingsRedis: execute request editors: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-settings-redis")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsRedis: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-settings-redis"); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsRedis: http response: %w", err)
 	}
 
@@ -6443,24 +5418,12 @@ func (c Client) CreateDBAASTaskMigrationCheck(ctx context.Context, service strin
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-task-migration-check")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dbaas-task-migration-check")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dbaas-task-migration-check"); err != nil {
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: http response: %w", err)
 	}
 
@@ -6486,24 +5449,12 @@ func (c Client) GetDBAASTask(ctx context.Context, service string, id UUID) (*DBA
 		return nil, fmt.Errorf("GetDBAASTask: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASTask: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-task")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dbaas-task")
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASTask: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dbaas-task"); err != nil {
 		return nil, fmt.Errorf("GetDBAASTask: http response: %w", err)
 	}
 
@@ -6544,24 +5495,12 @@ func (c Client) ListDeployTargets(ctx context.Context) (*ListDeployTargetsRespon
 		return nil, fmt.Errorf("ListDeployTargets: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDeployTargets: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-deploy-targets")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-deploy-targets")
 	if err != nil {
 		return nil, fmt.Errorf("ListDeployTargets: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-deploy-targets"); err != nil {
 		return nil, fmt.Errorf("ListDeployTargets: http response: %w", err)
 	}
 
@@ -6573,6 +5512,23 @@ func (c Client) ListDeployTargets(ctx context.Context) (*ListDeployTargetsRespon
 	return bodyresp, nil
 }
 
+// AllDeployTargets returns an iterator over the DeployTarget returned by ListDeployTargets.
+func (c Client) AllDeployTargets(ctx context.Context) iter.Seq2[DeployTarget, error] {
+	return func(yield func(DeployTarget, error) bool) {
+		resp, err := c.ListDeployTargets(ctx)
+		if err != nil {
+			yield(DeployTarget{}, err)
+			return
+		}
+
+		for _, elem := range resp.DeployTargets {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve Deploy Target details
 func (c Client) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, error) {
 	path := fmt.Sprintf("/deploy-target/%v", id)
@@ -6587,24 +5543,12 @@ func (c Client) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, er
 		return nil, fmt.Errorf("GetDeployTarget: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeployTarget: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deploy-target")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-deploy-target")
 	if err != nil {
 		return nil, fmt.Errorf("GetDeployTarget: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-deploy-target"); err != nil {
 		return nil, fmt.Errorf("GetDeployTarget: http response: %w", err)
 	}
 
@@ -6634,24 +5578,12 @@ func (c Client) ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, er
 		return nil, fmt.Errorf("ListDNSDomains: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDNSDomains: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dns-domains")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-dns-domains")
 	if err != nil {
 		return nil, fmt.Errorf("ListDNSDomains: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-dns-domains"); err != nil {
 		return nil, fmt.Errorf("ListDNSDomains: http response: %w", err)
 	}
 
@@ -6663,6 +5595,23 @@ func (c Client) ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, er
 	return bodyresp, nil
 }
 
+// AllDNSDomains returns an iterator over the DNSDomain returned by ListDNSDomains.
+func (c Client) AllDNSDomains(ctx context.Context) iter.Seq2[DNSDomain, error] {
+	return func(yield func(DNSDomain, error) bool) {
+		resp, err := c.ListDNSDomains(ctx)
+		if err != nil {
+			yield(DNSDomain{}, err)
+			return
+		}
+
+		for _, elem := range resp.DNSDomains {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // DNS Domain
 type CreateDNSDomainRequest struct {
 	// Domain name
@@ -6690,24 +5639,12 @@ func (c Client) CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest)
 		return nil, fmt.Errorf("CreateDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dns-domain")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDNSDomain: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dns-domain"); err != nil {
 		return nil, fmt.Errorf("CreateDNSDomain: http response: %w", err)
 	}
 
@@ -6748,24 +5685,12 @@ func (c Client) ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListD
 		return nil, fmt.Errorf("ListDNSDomainRecords: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDNSDomainRecords: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dns-domain-records")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-dns-domain-records")
 	if err != nil {
 		return nil, fmt.Errorf("ListDNSDomainRecords: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-dns-domain-records"); err != nil {
 		return nil, fmt.Errorf("ListDNSDomainRecords: http response: %w", err)
 	}
 
@@ -6777,6 +5702,23 @@ func (c Client) ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListD
 	return bodyresp, nil
 }
 
+// AllDNSDomainRecords returns an iterator over the DNSDomainRecord returned by ListDNSDomainRecords.
+func (c Client) AllDNSDomainRecords(ctx context.Context, domainID UUID) iter.Seq2[DNSDomainRecord, error] {
+	return func(yield func(DNSDomainRecord, error) bool) {
+		resp, err := c.ListDNSDomainRecords(ctx, domainID)
+		if err != nil {
+			yield(DNSDomainRecord{}, err)
+			return
+		}
+
+		for _, elem := range resp.DNSDomainRecords {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateDNSDomainRecordRequestType string
 
 const (
@@ -6831,24 +5773,12 @@ func (c Client) CreateDNSDomainRecord(ctx context.Context, domainID UUID, req Cr
 		return nil, fmt.Errorf("CreateDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-dns-domain-record")
 	if err != nil {
 		return nil, fmt.Errorf("CreateDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-dns-domain-record"); err != nil {
 		return nil, fmt.Errorf("CreateDNSDomainRecord: http response: %w", err)
 	}
 
@@ -6874,24 +5804,12 @@ func (c Client) DeleteDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dns-domain-record")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dns-domain-record"); err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: http response: %w", err)
 	}
 
@@ -6917,24 +5835,12 @@ func (c Client) GetDNSDomainRecord(ctx context.Context, domainID UUID, recordID
 		return nil, fmt.Errorf("GetDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dns-domain-record")
 	if err != nil {
 		return nil, fmt.Errorf("GetDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dns-domain-record"); err != nil {
 		return nil, fmt.Errorf("GetDNSDomainRecord: http response: %w", err)
 	}
 
@@ -6978,24 +5884,12 @@ func (c Client) UpdateDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-dns-domain-record")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-dns-domain-record"); err != nil {
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: http response: %w", err)
 	}
 
@@ -7021,24 +5915,12 @@ func (c Client) DeleteDNSDomain(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-dns-domain")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomain: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-dns-domain"); err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomain: http response: %w", err)
 	}
 
@@ -7064,24 +5946,12 @@ func (c Client) GetDNSDomain(ctx context.Context, id UUID) (*DNSDomain, error) {
 		return nil, fmt.Errorf("GetDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dns-domain")
 	if err != nil {
 		return nil, fmt.Errorf("GetDNSDomain: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dns-domain"); err != nil {
 		return nil, fmt.Errorf("GetDNSDomain: http response: %w", err)
 	}
 
@@ -7111,24 +5981,12 @@ func (c Client) GetDNSDomainZoneFile(ctx context.Context, id UUID) (*GetDNSDomai
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainZoneFile: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain-zone-file")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-dns-domain-zone-file")
 	if err != nil {
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-dns-domain-zone-file"); err != nil {
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: http response: %w", err)
 	}
 
@@ -7158,24 +6016,12 @@ func (c Client) ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, er
 		return nil, fmt.Errorf("ListElasticIPS: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListElasticIPS: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-elastic-ips")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-elastic-ips")
 	if err != nil {
 		return nil, fmt.Errorf("ListElasticIPS: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-elastic-ips"); err != nil {
 		return nil, fmt.Errorf("ListElasticIPS: http response: %w", err)
 	}
 
@@ -7187,6 +6033,23 @@ func (c Client) ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, er
 	return bodyresp, nil
 }
 
+// AllElasticIPS returns an iterator over the ElasticIP returned by ListElasticIPS.
+func (c Client) AllElasticIPS(ctx context.Context) iter.Seq2[ElasticIP, error] {
+	return func(yield func(ElasticIP, error) bool) {
+		resp, err := c.ListElasticIPS(ctx)
+		if err != nil {
+			yield(ElasticIP{}, err)
+			return
+		}
+
+		for _, elem := range resp.ElasticIPS {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateElasticIPRequestAddressfamily string
 
 const (
@@ -7225,24 +6088,12 @@ func (c Client) CreateElasticIP(ctx context.Context, req CreateElasticIPRequest)
 		return nil, fmt.Errorf("CreateElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("CreateElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("CreateElasticIP: http response: %w", err)
 	}
 
@@ -7268,24 +6119,12 @@ func (c Client) DeleteElasticIP(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("DeleteElasticIP: http response: %w", err)
 	}
 
@@ -7311,24 +6150,12 @@ func (c Client) GetElasticIP(ctx context.Context, id UUID) (*ElasticIP, error) {
 		return nil, fmt.Errorf("GetElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("GetElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("GetElasticIP: http response: %w", err)
 	}
 
@@ -7369,24 +6196,12 @@ func (c Client) UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticI
 		return nil, fmt.Errorf("UpdateElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("UpdateElasticIP: http response: %w", err)
 	}
 
@@ -7418,24 +6233,12 @@ func (c Client) ResetElasticIPField(ctx context.Context, id UUID, field ResetEla
 		return nil, fmt.Errorf("ResetElasticIPField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetElasticIPField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-elastic-ip-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-elastic-ip-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetElasticIPField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-elastic-ip-field"); err != nil {
 		return nil, fmt.Errorf("ResetElasticIPField: http response: %w", err)
 	}
 
@@ -7473,24 +6276,12 @@ func (c Client) AttachInstanceToElasticIP(ctx context.Context, id UUID, req Atta
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "attach-instance-to-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "attach-instance-to-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: http response: %w", err)
 	}
 
@@ -7528,24 +6319,12 @@ func (c Client) DetachInstanceFromElasticIP(ctx context.Context, id UUID, req De
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "detach-instance-from-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "detach-instance-from-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: http response: %w", err)
 	}
 
@@ -7561,13 +6340,13 @@ type ListEventsOpt func(url.Values)
 
 func ListEventsWithFrom(from time.Time) ListEventsOpt {
 	return func(q url.Values) {
-		q.Add("from", fmt.Sprint(from))
+		q.Add("from", from.Format(time.RFC3339))
 	}
 }
 
 func ListEventsWithTo(to time.Time) ListEventsOpt {
 	return func(q url.Values) {
-		q.Add("to", fmt.Sprint(to))
+		q.Add("to", to.Format(time.RFC3339))
 	}
 }
 
@@ -7595,35 +6374,48 @@ func (c Client) ListEvents(ctx context.Context, opts ...ListEventsOpt) ([]Event,
 		return nil, fmt.Errorf("ListEvents: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListEvents: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-events")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-events")
 	if err != nil {
 		return nil, fmt.Errorf("ListEvents: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-events"); err != nil {
 		return nil, fmt.Errorf("ListEvents: http response: %w", err)
 	}
 
 	bodyresp := []Event{}
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
+	if err := prepareJSONResponse(response, &bodyresp); err != nil {
 		return nil, fmt.Errorf("ListEvents: prepare Json response: %w", err)
 	}
 
 	return bodyresp, nil
 }
 
+// AllEvents returns an iterator over the Event returned by ListEvents
+// between from and to, requesting successive time windows.
+func (c Client) AllEvents(ctx context.Context, from, to time.Time) iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		for start := from; start.Before(to); start = start.Add(paginationTimeWindow) {
+			end := start.Add(paginationTimeWindow)
+			if end.After(to) {
+				end = to
+			}
+
+			resp, err := c.ListEvents(ctx, ListEventsWithFrom(start), ListEventsWithTo(end))
+			if err != nil {
+				yield(Event{}, err)
+				return
+			}
+
+			for _, elem := range resp {
+				if !yield(elem, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Retrieve IAM Organization Policy
 func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error) {
 	path := "/iam-organization-policy"
@@ -7638,24 +6430,12 @@ func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-iam-organization-policy")
 	if err != nil {
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-iam-organization-policy"); err != nil {
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: http response: %w", err)
 	}
 
@@ -7688,24 +6468,12 @@ func (c Client) UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-iam-organization-policy")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-iam-organization-policy"); err != nil {
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: http response: %w", err)
 	}
 
@@ -7746,24 +6514,12 @@ func (c Client) ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error)
 		return nil, fmt.Errorf("ListIAMRoles: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListIAMRoles: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-iam-roles")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-iam-roles")
 	if err != nil {
 		return nil, fmt.Errorf("ListIAMRoles: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-iam-roles"); err != nil {
 		return nil, fmt.Errorf("ListIAMRoles: http response: %w", err)
 	}
 
@@ -7775,6 +6531,23 @@ func (c Client) ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error)
 	return bodyresp, nil
 }
 
+// AllIAMRoles returns an iterator over the IAMRole returned by ListIAMRoles.
+func (c Client) AllIAMRoles(ctx context.Context) iter.Seq2[IAMRole, error] {
+	return func(yield func(IAMRole, error) bool) {
+		resp, err := c.ListIAMRoles(ctx)
+		if err != nil {
+			yield(IAMRole{}, err)
+			return
+		}
+
+		for _, elem := range resp.IAMRoles {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateIAMRoleRequest struct {
 	// IAM Role description
 	Description string `json:"description,omitempty" validate:"omitempty,gte=1,lte=255"`
@@ -7810,24 +6583,12 @@ func (c Client) CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*O
 		return nil, fmt.Errorf("CreateIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-iam-role")
 	if err != nil {
 		return nil, fmt.Errorf("CreateIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-iam-role"); err != nil {
 		return nil, fmt.Errorf("CreateIAMRole: http response: %w", err)
 	}
 
@@ -7853,24 +6614,12 @@ func (c Client) DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-iam-role")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-iam-role"); err != nil {
 		return nil, fmt.Errorf("DeleteIAMRole: http response: %w", err)
 	}
 
@@ -7896,24 +6645,12 @@ func (c Client) GetIAMRole(ctx context.Context, id UUID) (*IAMRole, error) {
 		return nil, fmt.Errorf("GetIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-iam-role")
 	if err != nil {
 		return nil, fmt.Errorf("GetIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-iam-role"); err != nil {
 		return nil, fmt.Errorf("GetIAMRole: http response: %w", err)
 	}
 
@@ -7954,24 +6691,12 @@ func (c Client) UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleReq
 		return nil, fmt.Errorf("UpdateIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-iam-role")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-iam-role"); err != nil {
 		return nil, fmt.Errorf("UpdateIAMRole: http response: %w", err)
 	}
 
@@ -8004,24 +6729,12 @@ func (c Client) UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRolePolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-role-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-iam-role-policy")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-iam-role-policy"); err != nil {
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: http response: %w", err)
 	}
 
@@ -8137,24 +6850,12 @@ func (c Client) ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*L
 		return nil, fmt.Errorf("ListInstances: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstances: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-instances")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-instances")
 	if err != nil {
 		return nil, fmt.Errorf("ListInstances: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-instances"); err != nil {
 		return nil, fmt.Errorf("ListInstances: http response: %w", err)
 	}
 
@@ -8166,6 +6867,23 @@ func (c Client) ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*L
 	return bodyresp, nil
 }
 
+// AllInstances returns an iterator over the ListInstancesResponseInstances returned by ListInstances.
+func (c Client) AllInstances(ctx context.Context, opts ...ListInstancesOpt) iter.Seq2[ListInstancesResponseInstances, error] {
+	return func(yield func(ListInstancesResponseInstances, error) bool) {
+		resp, err := c.ListInstances(ctx, opts...)
+		if err != nil {
+			yield(ListInstancesResponseInstances{}, err)
+			return
+		}
+
+		for _, elem := range resp.Instances {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateInstanceRequest struct {
 	// Instance Anti-affinity Groups
 	AntiAffinityGroups []AntiAffinityGroup `json:"anti-affinity-groups,omitempty"`
@@ -8216,24 +6934,12 @@ func (c Client) CreateInstance(ctx context.Context, req CreateInstanceRequest) (
 		return nil, fmt.Errorf("CreateInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-instance")
 	if err != nil {
 		return nil, fmt.Errorf("CreateInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-instance"); err != nil {
 		return nil, fmt.Errorf("CreateInstance: http response: %w", err)
 	}
 
@@ -8274,24 +6980,12 @@ func (c Client) ListInstancePools(ctx context.Context) (*ListInstancePoolsRespon
 		return nil, fmt.Errorf("ListInstancePools: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstancePools: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-instance-pools")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-instance-pools")
 	if err != nil {
 		return nil, fmt.Errorf("ListInstancePools: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-instance-pools"); err != nil {
 		return nil, fmt.Errorf("ListInstancePools: http response: %w", err)
 	}
 
@@ -8303,6 +6997,23 @@ func (c Client) ListInstancePools(ctx context.Context) (*ListInstancePoolsRespon
 	return bodyresp, nil
 }
 
+// AllInstancePools returns an iterator over the InstancePool returned by ListInstancePools.
+func (c Client) AllInstancePools(ctx context.Context) iter.Seq2[InstancePool, error] {
+	return func(yield func(InstancePool, error) bool) {
+		resp, err := c.ListInstancePools(ctx)
+		if err != nil {
+			yield(InstancePool{}, err)
+			return
+		}
+
+		for _, elem := range resp.InstancePools {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateInstancePoolRequestPublicIPAssignment string
 
 const (
@@ -8372,24 +7083,12 @@ func (c Client) CreateInstancePool(ctx context.Context, req CreateInstancePoolRe
 		return nil, fmt.Errorf("CreateInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-instance-pool")
 	if err != nil {
 		return nil, fmt.Errorf("CreateInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-instance-pool"); err != nil {
 		return nil, fmt.Errorf("CreateInstancePool: http response: %w", err)
 	}
 
@@ -8415,24 +7114,12 @@ func (c Client) DeleteInstancePool(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-instance-pool")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-instance-pool"); err != nil {
 		return nil, fmt.Errorf("DeleteInstancePool: http response: %w", err)
 	}
 
@@ -8458,24 +7145,12 @@ func (c Client) GetInstancePool(ctx context.Context, id UUID) (*InstancePool, er
 		return nil, fmt.Errorf("GetInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-instance-pool")
 	if err != nil {
 		return nil, fmt.Errorf("GetInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-instance-pool"); err != nil {
 		return nil, fmt.Errorf("GetInstancePool: http response: %w", err)
 	}
 
@@ -8487,6 +7162,29 @@ func (c Client) GetInstancePool(ctx context.Context, id UUID) (*InstancePool, er
 	return bodyresp, nil
 }
 
+// WaitInstancePoolState waits for the InstancePool to reach the given state, polling GetInstancePool.
+func (c Client) WaitInstancePoolState(ctx context.Context, id UUID, state InstancePoolState, opts ...WaitOpt) (*InstancePool, error) {
+	var resource *InstancePool
+	err := c.poll(ctx, "WaitInstancePoolState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetInstancePool(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitInstancePoolState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitInstancePoolState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateInstancePoolRequestPublicIPAssignment string
 
 const (
@@ -8553,24 +7251,12 @@ func (c Client) UpdateInstancePool(ctx context.Context, id UUID, req UpdateInsta
 		return nil, fmt.Errorf("UpdateInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-instance-pool")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-instance-pool"); err != nil {
 		return nil, fmt.Errorf("UpdateInstancePool: http response: %w", err)
 	}
 
@@ -8611,24 +7297,12 @@ func (c Client) ResetInstancePoolField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetInstancePoolField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstancePoolField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-pool-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-instance-pool-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstancePoolField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-instance-pool-field"); err != nil {
 		return nil, fmt.Errorf("ResetInstancePoolField: http response: %w", err)
 	}
 
@@ -8665,24 +7339,12 @@ func (c Client) EvictInstancePoolMembers(ctx context.Context, id UUID, req Evict
 		return nil, fmt.Errorf("EvictInstancePoolMembers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EvictInstancePoolMembers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "evict-instance-pool-members")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "evict-instance-pool-members")
 	if err != nil {
 		return nil, fmt.Errorf("EvictInstancePoolMembers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "evict-instance-pool-members"); err != nil {
 		return nil, fmt.Errorf("EvictInstancePoolMembers: http response: %w", err)
 	}
 
@@ -8720,24 +7382,12 @@ func (c Client) ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstanc
 		return nil, fmt.Errorf("ScaleInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "scale-instance-pool")
 	if err != nil {
 		return nil, fmt.Errorf("ScaleInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "scale-instance-pool"); err != nil {
 		return nil, fmt.Errorf("ScaleInstancePool: http response: %w", err)
 	}
 
@@ -8767,24 +7417,12 @@ func (c Client) ListInstanceTypes(ctx context.Context) (*ListInstanceTypesRespon
 		return nil, fmt.Errorf("ListInstanceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstanceTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-instance-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-instance-types")
 	if err != nil {
 		return nil, fmt.Errorf("ListInstanceTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-instance-types"); err != nil {
 		return nil, fmt.Errorf("ListInstanceTypes: http response: %w", err)
 	}
 
@@ -8796,6 +7434,23 @@ func (c Client) ListInstanceTypes(ctx context.Context) (*ListInstanceTypesRespon
 	return bodyresp, nil
 }
 
+// AllInstanceTypes returns an iterator over the InstanceType returned by ListInstanceTypes.
+func (c Client) AllInstanceTypes(ctx context.Context) iter.Seq2[InstanceType, error] {
+	return func(yield func(InstanceType, error) bool) {
+		resp, err := c.ListInstanceTypes(ctx)
+		if err != nil {
+			yield(InstanceType{}, err)
+			return
+		}
+
+		for _, elem := range resp.InstanceTypes {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve Instance Type details
 func (c Client) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, error) {
 	path := fmt.Sprintf("/instance-type/%v", id)
@@ -8810,24 +7465,12 @@ func (c Client) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, er
 		return nil, fmt.Errorf("GetInstanceType: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstanceType: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance-type")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-instance-type")
 	if err != nil {
 		return nil, fmt.Errorf("GetInstanceType: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-instance-type"); err != nil {
 		return nil, fmt.Errorf("GetInstanceType: http response: %w", err)
 	}
 
@@ -8853,24 +7496,12 @@ func (c Client) DeleteInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-instance")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-instance"); err != nil {
 		return nil, fmt.Errorf("DeleteInstance: http response: %w", err)
 	}
 
@@ -8896,24 +7527,12 @@ func (c Client) GetInstance(ctx context.Context, id UUID) (*Instance, error) {
 		return nil, fmt.Errorf("GetInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-instance")
 	if err != nil {
 		return nil, fmt.Errorf("GetInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-instance"); err != nil {
 		return nil, fmt.Errorf("GetInstance: http response: %w", err)
 	}
 
@@ -8925,6 +7544,30 @@ func (c Client) GetInstance(ctx context.Context, id UUID) (*Instance, error) {
 	return bodyresp, nil
 }
 
+// WaitInstanceState waits for the Instance to reach the given state, polling GetInstance.
+// The wait fails if the Instance reaches the error state.
+func (c Client) WaitInstanceState(ctx context.Context, id UUID, state InstanceState, opts ...WaitOpt) (*Instance, error) {
+	var resource *Instance
+	err := c.poll(ctx, "WaitInstanceState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetInstance(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == InstanceStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitInstanceState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitInstanceState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateInstanceRequest struct {
 	Labels Labels `json:"labels,omitempty"`
 	// Instance name
@@ -8955,24 +7598,12 @@ func (c Client) UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceR
 		return nil, fmt.Errorf("UpdateInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-instance")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-instance"); err != nil {
 		return nil, fmt.Errorf("UpdateInstance: http response: %w", err)
 	}
 
@@ -9004,24 +7635,12 @@ func (c Client) ResetInstanceField(ctx context.Context, id UUID, field ResetInst
 		return nil, fmt.Errorf("ResetInstanceField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstanceField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-instance-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstanceField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-instance-field"); err != nil {
 		return nil, fmt.Errorf("ResetInstanceField: http response: %w", err)
 	}
 
@@ -9047,24 +7666,12 @@ func (c Client) AddInstanceProtection(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("AddInstanceProtection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddInstanceProtection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-instance-protection")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "add-instance-protection")
 	if err != nil {
 		return nil, fmt.Errorf("AddInstanceProtection: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "add-instance-protection"); err != nil {
 		return nil, fmt.Errorf("AddInstanceProtection: http response: %w", err)
 	}
 
@@ -9090,24 +7697,12 @@ func (c Client) CreateSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("CreateSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("CreateSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-snapshot"); err != nil {
 		return nil, fmt.Errorf("CreateSnapshot: http response: %w", err)
 	}
 
@@ -9137,24 +7732,12 @@ func (c Client) RevealInstancePassword(ctx context.Context, id UUID) (*InstanceP
 		return nil, fmt.Errorf("RevealInstancePassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealInstancePassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-instance-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reveal-instance-password")
 	if err != nil {
 		return nil, fmt.Errorf("RevealInstancePassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reveal-instance-password"); err != nil {
 		return nil, fmt.Errorf("RevealInstancePassword: http response: %w", err)
 	}
 
@@ -9180,24 +7763,12 @@ func (c Client) RebootInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("RebootInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RebootInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reboot-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reboot-instance")
 	if err != nil {
 		return nil, fmt.Errorf("RebootInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reboot-instance"); err != nil {
 		return nil, fmt.Errorf("RebootInstance: http response: %w", err)
 	}
 
@@ -9223,24 +7794,12 @@ func (c Client) RemoveInstanceProtection(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("RemoveInstanceProtection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RemoveInstanceProtection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "remove-instance-protection")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "remove-instance-protection")
 	if err != nil {
 		return nil, fmt.Errorf("RemoveInstanceProtection: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "remove-instance-protection"); err != nil {
 		return nil, fmt.Errorf("RemoveInstanceProtection: http response: %w", err)
 	}
 
@@ -9280,24 +7839,12 @@ func (c Client) ResetInstance(ctx context.Context, id UUID, req ResetInstanceReq
 		return nil, fmt.Errorf("ResetInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-instance")
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-instance"); err != nil {
 		return nil, fmt.Errorf("ResetInstance: http response: %w", err)
 	}
 
@@ -9323,24 +7870,12 @@ func (c Client) ResetInstancePassword(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("ResetInstancePassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstancePassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-instance-password")
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstancePassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-instance-password"); err != nil {
 		return nil, fmt.Errorf("ResetInstancePassword: http response: %w", err)
 	}
 
@@ -9378,24 +7913,12 @@ func (c Client) ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInsta
 		return nil, fmt.Errorf("ResizeInstanceDisk: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResizeInstanceDisk: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "resize-instance-disk")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "resize-instance-disk")
 	if err != nil {
 		return nil, fmt.Errorf("ResizeInstanceDisk: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "resize-instance-disk"); err != nil {
 		return nil, fmt.Errorf("ResizeInstanceDisk: http response: %w", err)
 	}
 
@@ -9433,24 +7956,12 @@ func (c Client) ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceReq
 		return nil, fmt.Errorf("ScaleInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "scale-instance")
 	if err != nil {
 		return nil, fmt.Errorf("ScaleInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "scale-instance"); err != nil {
 		return nil, fmt.Errorf("ScaleInstance: http response: %w", err)
 	}
 
@@ -9495,24 +8006,12 @@ func (c Client) StartInstance(ctx context.Context, id UUID, req StartInstanceReq
 		return nil, fmt.Errorf("StartInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "start-instance")
 	if err != nil {
 		return nil, fmt.Errorf("StartInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "start-instance"); err != nil {
 		return nil, fmt.Errorf("StartInstance: http response: %w", err)
 	}
 
@@ -9538,24 +8037,12 @@ func (c Client) StopInstance(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("StopInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "stop-instance")
 	if err != nil {
 		return nil, fmt.Errorf("StopInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "stop-instance"); err != nil {
 		return nil, fmt.Errorf("StopInstance: http response: %w", err)
 	}
 
@@ -9594,24 +8081,12 @@ func (c Client) RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, r
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevertInstanceToSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "revert-instance-to-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "revert-instance-to-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "revert-instance-to-snapshot"); err != nil {
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: http response: %w", err)
 	}
 
@@ -9652,24 +8127,12 @@ func (c Client) ListLoadBalancers(ctx context.Context) (*ListLoadBalancersRespon
 		return nil, fmt.Errorf("ListLoadBalancers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListLoadBalancers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-load-balancers")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-load-balancers")
 	if err != nil {
 		return nil, fmt.Errorf("ListLoadBalancers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-load-balancers"); err != nil {
 		return nil, fmt.Errorf("ListLoadBalancers: http response: %w", err)
 	}
 
@@ -9681,6 +8144,23 @@ func (c Client) ListLoadBalancers(ctx context.Context) (*ListLoadBalancersRespon
 	return bodyresp, nil
 }
 
+// AllLoadBalancers returns an iterator over the LoadBalancer returned by ListLoadBalancers.
+func (c Client) AllLoadBalancers(ctx context.Context) iter.Seq2[LoadBalancer, error] {
+	return func(yield func(LoadBalancer, error) bool) {
+		resp, err := c.ListLoadBalancers(ctx)
+		if err != nil {
+			yield(LoadBalancer{}, err)
+			return
+		}
+
+		for _, elem := range resp.LoadBalancers {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateLoadBalancerRequest struct {
 	// Load Balancer description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -9710,24 +8190,12 @@ func (c Client) CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRe
 		return nil, fmt.Errorf("CreateLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-load-balancer")
 	if err != nil {
 		return nil, fmt.Errorf("CreateLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-load-balancer"); err != nil {
 		return nil, fmt.Errorf("CreateLoadBalancer: http response: %w", err)
 	}
 
@@ -9753,24 +8221,12 @@ func (c Client) DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-load-balancer")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-load-balancer"); err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancer: http response: %w", err)
 	}
 
@@ -9796,24 +8252,12 @@ func (c Client) GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, er
 		return nil, fmt.Errorf("GetLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-load-balancer")
 	if err != nil {
 		return nil, fmt.Errorf("GetLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-load-balancer"); err != nil {
 		return nil, fmt.Errorf("GetLoadBalancer: http response: %w", err)
 	}
 
@@ -9825,6 +8269,30 @@ func (c Client) GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, er
 	return bodyresp, nil
 }
 
+// WaitLoadBalancerState waits for the LoadBalancer to reach the given state, polling GetLoadBalancer.
+// The wait fails if the LoadBalancer reaches the error state.
+func (c Client) WaitLoadBalancerState(ctx context.Context, id UUID, state LoadBalancerState, opts ...WaitOpt) (*LoadBalancer, error) {
+	var resource *LoadBalancer
+	err := c.poll(ctx, "WaitLoadBalancerState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetLoadBalancer(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == LoadBalancerStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitLoadBalancerState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitLoadBalancerState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateLoadBalancerRequest struct {
 	// Load Balancer description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -9854,24 +8322,12 @@ func (c Client) UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadB
 		return nil, fmt.Errorf("UpdateLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-load-balancer")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-load-balancer"); err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancer: http response: %w", err)
 	}
 
@@ -9938,24 +8394,12 @@ func (c Client) AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddSe
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddServiceToLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-service-to-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "add-service-to-load-balancer")
 	if err != nil {
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "add-service-to-load-balancer"); err != nil {
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: http response: %w", err)
 	}
 
@@ -9981,24 +8425,12 @@ func (c Client) DeleteLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("DeleteLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-load-balancer-service")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancerService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-load-balancer-service"); err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancerService: http response: %w", err)
 	}
 
@@ -10024,24 +8456,12 @@ func (c Client) GetLoadBalancerService(ctx context.Context, id UUID, serviceID U
 		return nil, fmt.Errorf("GetLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-load-balancer-service")
 	if err != nil {
 		return nil, fmt.Errorf("GetLoadBalancerService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-load-balancer-service"); err != nil {
 		return nil, fmt.Errorf("GetLoadBalancerService: http response: %w", err)
 	}
 
@@ -10053,6 +8473,30 @@ func (c Client) GetLoadBalancerService(ctx context.Context, id UUID, serviceID U
 	return bodyresp, nil
 }
 
+// WaitLoadBalancerServiceState waits for the LoadBalancerService to reach the given state, polling GetLoadBalancerService.
+// The wait fails if the LoadBalancerService reaches the error state.
+func (c Client) WaitLoadBalancerServiceState(ctx context.Context, id UUID, serviceID UUID, state LoadBalancerServiceState, opts ...WaitOpt) (*LoadBalancerService, error) {
+	var resource *LoadBalancerService
+	err := c.poll(ctx, "WaitLoadBalancerServiceState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetLoadBalancerService(ctx, id, serviceID)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == LoadBalancerServiceStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitLoadBalancerServiceState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitLoadBalancerServiceState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateLoadBalancerServiceRequestProtocol string
 
 const (
@@ -10106,24 +8550,12 @@ func (c Client) UpdateLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("UpdateLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-load-balancer-service")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancerService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-load-balancer-service"); err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancerService: http response: %w", err)
 	}
 
@@ -10155,24 +8587,12 @@ func (c Client) ResetLoadBalancerServiceField(ctx context.Context, id UUID, serv
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerServiceField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-load-balancer-service-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-load-balancer-service-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-load-balancer-service-field"); err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: http response: %w", err)
 	}
 
@@ -10205,24 +8625,12 @@ func (c Client) ResetLoadBalancerField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetLoadBalancerField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-load-balancer-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-load-balancer-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-load-balancer-field"); err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerField: http response: %w", err)
 	}
 
@@ -10248,24 +8656,12 @@ func (c Client) GetOperation(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("GetOperation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetOperation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-operation")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-operation")
 	if err != nil {
 		return nil, fmt.Errorf("GetOperation: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-operation"); err != nil {
 		return nil, fmt.Errorf("GetOperation: http response: %w", err)
 	}
 
@@ -10291,24 +8687,12 @@ func (c Client) GetOrganization(ctx context.Context) (*Organization, error) {
 		return nil, fmt.Errorf("GetOrganization: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetOrganization: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-organization")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-organization")
 	if err != nil {
 		return nil, fmt.Errorf("GetOrganization: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-organization"); err != nil {
 		return nil, fmt.Errorf("GetOrganization: http response: %w", err)
 	}
 
@@ -10349,24 +8733,12 @@ func (c Client) ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksRe
 		return nil, fmt.Errorf("ListPrivateNetworks: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListPrivateNetworks: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-private-networks")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-private-networks")
 	if err != nil {
 		return nil, fmt.Errorf("ListPrivateNetworks: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-private-networks"); err != nil {
 		return nil, fmt.Errorf("ListPrivateNetworks: http response: %w", err)
 	}
 
@@ -10378,6 +8750,23 @@ func (c Client) ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksRe
 	return bodyresp, nil
 }
 
+// AllPrivateNetworks returns an iterator over the PrivateNetwork returned by ListPrivateNetworks.
+func (c Client) AllPrivateNetworks(ctx context.Context) iter.Seq2[PrivateNetwork, error] {
+	return func(yield func(PrivateNetwork, error) bool) {
+		resp, err := c.ListPrivateNetworks(ctx)
+		if err != nil {
+			yield(PrivateNetwork{}, err)
+			return
+		}
+
+		for _, elem := range resp.PrivateNetworks {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreatePrivateNetworkRequest struct {
 	// Private Network description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -10413,24 +8802,12 @@ func (c Client) CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetwo
 		return nil, fmt.Errorf("CreatePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreatePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-private-network")
 	if err != nil {
 		return nil, fmt.Errorf("CreatePrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-private-network"); err != nil {
 		return nil, fmt.Errorf("CreatePrivateNetwork: http response: %w", err)
 	}
 
@@ -10456,24 +8833,12 @@ func (c Client) DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("DeletePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeletePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-private-network")
 	if err != nil {
 		return nil, fmt.Errorf("DeletePrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-private-network"); err != nil {
 		return nil, fmt.Errorf("DeletePrivateNetwork: http response: %w", err)
 	}
 
@@ -10499,24 +8864,12 @@ func (c Client) GetPrivateNetwork(ctx context.Context, id UUID) (*PrivateNetwork
 		return nil, fmt.Errorf("GetPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-private-network")
 	if err != nil {
 		return nil, fmt.Errorf("GetPrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-private-network"); err != nil {
 		return nil, fmt.Errorf("GetPrivateNetwork: http response: %w", err)
 	}
 
@@ -10563,24 +8916,12 @@ func (c Client) UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePri
 		return nil, fmt.Errorf("UpdatePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-private-network")
 	if err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-private-network"); err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetwork: http response: %w", err)
 	}
 
@@ -10612,24 +8953,12 @@ func (c Client) ResetPrivateNetworkField(ctx context.Context, id UUID, field Res
 		return nil, fmt.Errorf("ResetPrivateNetworkField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-private-network-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-private-network-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetPrivateNetworkField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-private-network-field"); err != nil {
 		return nil, fmt.Errorf("ResetPrivateNetworkField: http response: %w", err)
 	}
 
@@ -10675,24 +9004,12 @@ func (c Client) AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "attach-instance-to-private-network")
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "attach-instance-to-private-network"); err != nil {
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: http response: %w", err)
 	}
 
@@ -10730,24 +9047,12 @@ func (c Client) DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "detach-instance-from-private-network")
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "detach-instance-from-private-network"); err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: http response: %w", err)
 	}
 
@@ -10791,24 +9096,12 @@ func (c Client) UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-private-network-instance-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-private-network-instance-ip")
 	if err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-private-network-instance-ip"); err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: http response: %w", err)
 	}
 
@@ -10838,24 +9131,12 @@ func (c Client) ListQuotas(ctx context.Context) (*ListQuotasResponse, error) {
 		return nil, fmt.Errorf("ListQuotas: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListQuotas: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-quotas")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-quotas")
 	if err != nil {
 		return nil, fmt.Errorf("ListQuotas: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-quotas"); err != nil {
 		return nil, fmt.Errorf("ListQuotas: http response: %w", err)
 	}
 
@@ -10867,6 +9148,23 @@ func (c Client) ListQuotas(ctx context.Context) (*ListQuotasResponse, error) {
 	return bodyresp, nil
 }
 
+// AllQuotas returns an iterator over the Quota returned by ListQuotas.
+func (c Client) AllQuotas(ctx context.Context) iter.Seq2[Quota, error] {
+	return func(yield func(Quota, error) bool) {
+		resp, err := c.ListQuotas(ctx)
+		if err != nil {
+			yield(Quota{}, err)
+			return
+		}
+
+		for _, elem := range resp.Quotas {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve Resource Quota
 func (c Client) GetQuota(ctx context.Context, entity string) (*Quota, error) {
 	path := fmt.Sprintf("/quota/%v", entity)
@@ -10881,24 +9179,12 @@ func (c Client) GetQuota(ctx context.Context, entity string) (*Quota, error) {
 		return nil, fmt.Errorf("GetQuota: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetQuota: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-quota")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-quota")
 	if err != nil {
 		return nil, fmt.Errorf("GetQuota: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-quota"); err != nil {
 		return nil, fmt.Errorf("GetQuota: http response: %w", err)
 	}
 
@@ -10924,24 +9210,12 @@ func (c Client) DeleteReverseDNSElasticIP(ctx context.Context, id UUID) (*Operat
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-reverse-dns-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-reverse-dns-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: http response: %w", err)
 	}
 
@@ -10967,24 +9241,12 @@ func (c Client) GetReverseDNSElasticIP(ctx context.Context, id UUID) (*ReverseDN
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-reverse-dns-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-reverse-dns-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: http response: %w", err)
 	}
 
@@ -11021,24 +9283,12 @@ func (c Client) UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req Upda
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-reverse-dns-elastic-ip")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-reverse-dns-elastic-ip"); err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: http response: %w", err)
 	}
 
@@ -11064,24 +9314,12 @@ func (c Client) DeleteReverseDNSInstance(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-reverse-dns-instance")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-reverse-dns-instance"); err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: http response: %w", err)
 	}
 
@@ -11107,24 +9345,12 @@ func (c Client) GetReverseDNSInstance(ctx context.Context, id UUID) (*ReverseDNS
 		return nil, fmt.Errorf("GetReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-reverse-dns-instance")
 	if err != nil {
 		return nil, fmt.Errorf("GetReverseDNSInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-reverse-dns-instance"); err != nil {
 		return nil, fmt.Errorf("GetReverseDNSInstance: http response: %w", err)
 	}
 
@@ -11161,24 +9387,12 @@ func (c Client) UpdateReverseDNSInstance(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-reverse-dns-instance")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-reverse-dns-instance"); err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: http response: %w", err)
 	}
 
@@ -11245,24 +9459,12 @@ func (c Client) ListSecurityGroups(ctx context.Context, opts ...ListSecurityGrou
 		return nil, fmt.Errorf("ListSecurityGroups: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSecurityGroups: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-security-groups")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-security-groups")
 	if err != nil {
 		return nil, fmt.Errorf("ListSecurityGroups: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-security-groups"); err != nil {
 		return nil, fmt.Errorf("ListSecurityGroups: http response: %w", err)
 	}
 
@@ -11274,6 +9476,23 @@ func (c Client) ListSecurityGroups(ctx context.Context, opts ...ListSecurityGrou
 	return bodyresp, nil
 }
 
+// AllSecurityGroups returns an iterator over the SecurityGroup returned by ListSecurityGroups.
+func (c Client) AllSecurityGroups(ctx context.Context, opts ...ListSecurityGroupsOpt) iter.Seq2[SecurityGroup, error] {
+	return func(yield func(SecurityGroup, error) bool) {
+		resp, err := c.ListSecurityGroups(ctx, opts...)
+		if err != nil {
+			yield(SecurityGroup{}, err)
+			return
+		}
+
+		for _, elem := range resp.SecurityGroups {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateSecurityGroupRequest struct {
 	// Security Group description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -11302,24 +9521,12 @@ func (c Client) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroup
 		return nil, fmt.Errorf("CreateSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("CreateSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-security-group"); err != nil {
 		return nil, fmt.Errorf("CreateSecurityGroup: http response: %w", err)
 	}
 
@@ -11345,24 +9552,12 @@ func (c Client) DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, e
 		return nil, fmt.Errorf("DeleteSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-security-group"); err != nil {
 		return nil, fmt.Errorf("DeleteSecurityGroup: http response: %w", err)
 	}
 
@@ -11388,24 +9583,12 @@ func (c Client) GetSecurityGroup(ctx context.Context, id UUID) (*SecurityGroup,
 		return nil, fmt.Errorf("GetSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("GetSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-security-group"); err != nil {
 		return nil, fmt.Errorf("GetSecurityGroup: http response: %w", err)
 	}
 
@@ -11483,24 +9666,12 @@ func (c Client) AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRule
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddRuleToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-rule-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "add-rule-to-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "add-rule-to-security-group"); err != nil {
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: http response: %w", err)
 	}
 
@@ -11526,24 +9697,12 @@ func (c Client) DeleteRuleFromSecurityGroup(ctx context.Context, id UUID, ruleID
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-rule-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-rule-from-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-rule-from-security-group"); err != nil {
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: http response: %w", err)
 	}
 
@@ -11581,24 +9740,12 @@ func (c Client) AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-external-source-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "add-external-source-to-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "add-external-source-to-security-group"); err != nil {
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: http response: %w", err)
 	}
 
@@ -11636,24 +9783,12 @@ func (c Client) AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "attach-instance-to-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "attach-instance-to-security-group"); err != nil {
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: http response: %w", err)
 	}
 
@@ -11691,24 +9826,12 @@ func (c Client) DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, re
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "detach-instance-from-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "detach-instance-from-security-group"); err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: http response: %w", err)
 	}
 
@@ -11746,24 +9869,12 @@ func (c Client) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UU
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "remove-external-source-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "remove-external-source-from-security-group")
 	if err != nil {
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "remove-external-source-from-security-group"); err != nil {
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: http response: %w", err)
 	}
 
@@ -11804,24 +9915,12 @@ func (c Client) ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse,
 		return nil, fmt.Errorf("ListSKSClusters: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusters: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sks-clusters")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-sks-clusters")
 	if err != nil {
 		return nil, fmt.Errorf("ListSKSClusters: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-sks-clusters"); err != nil {
 		return nil, fmt.Errorf("ListSKSClusters: http response: %w", err)
 	}
 
@@ -11833,6 +9932,23 @@ func (c Client) ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse,
 	return bodyresp, nil
 }
 
+// AllSKSClusters returns an iterator over the SKSCluster returned by ListSKSClusters.
+func (c Client) AllSKSClusters(ctx context.Context) iter.Seq2[SKSCluster, error] {
+	return func(yield func(SKSCluster, error) bool) {
+		resp, err := c.ListSKSClusters(ctx)
+		if err != nil {
+			yield(SKSCluster{}, err)
+			return
+		}
+
+		for _, elem := range resp.SKSClusters {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateSKSClusterRequestCni string
 
 const (
@@ -11888,24 +10004,12 @@ func (c Client) CreateSKSCluster(ctx context.Context, req CreateSKSClusterReques
 		return nil, fmt.Errorf("CreateSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-sks-cluster")
 	if err != nil {
 		return nil, fmt.Errorf("CreateSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-sks-cluster"); err != nil {
 		return nil, fmt.Errorf("CreateSKSCluster: http response: %w", err)
 	}
 
@@ -11931,35 +10035,40 @@ func (c Client) ListSKSClusterDeprecatedResources(ctx context.Context, id UUID)
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sks-cluster-deprecated-resources")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-sks-cluster-deprecated-resources")
 	if err != nil {
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-sks-cluster-deprecated-resources"); err != nil {
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: http response: %w", err)
 	}
 
 	bodyresp := []SKSClusterDeprecatedResource{}
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
+	if err := prepareJSONResponse(response, &bodyresp); err != nil {
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: prepare Json response: %w", err)
 	}
 
 	return bodyresp, nil
 }
 
+// AllSKSClusterDeprecatedResources returns an iterator over the SKSClusterDeprecatedResource returned by ListSKSClusterDeprecatedResources.
+func (c Client) AllSKSClusterDeprecatedResources(ctx context.Context, id UUID) iter.Seq2[SKSClusterDeprecatedResource, error] {
+	return func(yield func(SKSClusterDeprecatedResource, error) bool) {
+		resp, err := c.ListSKSClusterDeprecatedResources(ctx, id)
+		if err != nil {
+			yield(SKSClusterDeprecatedResource{}, err)
+			return
+		}
+
+		for _, elem := range resp {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GenerateSKSClusterKubeconfigResponse struct {
 	Kubeconfig string `json:"kubeconfig,omitempty"`
 }
@@ -11985,24 +10094,12 @@ func (c Client) GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req S
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-sks-cluster-kubeconfig")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "generate-sks-cluster-kubeconfig")
 	if err != nil {
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "generate-sks-cluster-kubeconfig"); err != nil {
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: http response: %w", err)
 	}
 
@@ -12048,24 +10145,12 @@ func (c Client) ListSKSClusterVersions(ctx context.Context, opts ...ListSKSClust
 		return nil, fmt.Errorf("ListSKSClusterVersions: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterVersions: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sks-cluster-versions")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-sks-cluster-versions")
 	if err != nil {
 		return nil, fmt.Errorf("ListSKSClusterVersions: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-sks-cluster-versions"); err != nil {
 		return nil, fmt.Errorf("ListSKSClusterVersions: http response: %w", err)
 	}
 
@@ -12091,24 +10176,12 @@ func (c Client) DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-sks-cluster")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-sks-cluster"); err != nil {
 		return nil, fmt.Errorf("DeleteSKSCluster: http response: %w", err)
 	}
 
@@ -12134,24 +10207,12 @@ func (c Client) GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error)
 		return nil, fmt.Errorf("GetSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-sks-cluster")
 	if err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: http client do: %w", err)
-	}
-
-	if c.trace {
-		dumpResponse(response)
+		return nil, fmt.Errorf("GetSKSCluster: http client do: %w", err)
 	}
 
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-sks-cluster"); err != nil {
 		return nil, fmt.Errorf("GetSKSCluster: http response: %w", err)
 	}
 
@@ -12163,6 +10224,30 @@ func (c Client) GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error)
 	return bodyresp, nil
 }
 
+// WaitSKSClusterState waits for the SKSCluster to reach the given state, polling GetSKSCluster.
+// The wait fails if the SKSCluster reaches the error state.
+func (c Client) WaitSKSClusterState(ctx context.Context, id UUID, state SKSClusterState, opts ...WaitOpt) (*SKSCluster, error) {
+	var resource *SKSCluster
+	err := c.poll(ctx, "WaitSKSClusterState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetSKSCluster(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == SKSClusterStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitSKSClusterState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitSKSClusterState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateSKSClusterRequest struct {
 	// Cluster addons
 	Addons []string `json:"addons,omitempty"`
@@ -12198,24 +10283,12 @@ func (c Client) UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClus
 		return nil, fmt.Errorf("UpdateSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-sks-cluster")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-sks-cluster"); err != nil {
 		return nil, fmt.Errorf("UpdateSKSCluster: http response: %w", err)
 	}
 
@@ -12253,24 +10326,12 @@ func (c Client) GetSKSClusterAuthorityCert(ctx context.Context, id UUID, authori
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster-authority-cert")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-sks-cluster-authority-cert")
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-sks-cluster-authority-cert"); err != nil {
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: http response: %w", err)
 	}
 
@@ -12298,24 +10359,12 @@ func (c Client) GetSKSClusterInspection(ctx context.Context, id UUID) (*GetSKSCl
 		return nil, fmt.Errorf("GetSKSClusterInspection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterInspection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster-inspection")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-sks-cluster-inspection")
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSClusterInspection: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-sks-cluster-inspection"); err != nil {
 		return nil, fmt.Errorf("GetSKSClusterInspection: http response: %w", err)
 	}
 
@@ -12377,24 +10426,12 @@ func (c Client) CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNod
 		return nil, fmt.Errorf("CreateSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "create-sks-nodepool")
 	if err != nil {
 		return nil, fmt.Errorf("CreateSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "create-sks-nodepool"); err != nil {
 		return nil, fmt.Errorf("CreateSKSNodepool: http response: %w", err)
 	}
 
@@ -12420,24 +10457,12 @@ func (c Client) DeleteSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("DeleteSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-sks-nodepool")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-sks-nodepool"); err != nil {
 		return nil, fmt.Errorf("DeleteSKSNodepool: http response: %w", err)
 	}
 
@@ -12463,24 +10488,12 @@ func (c Client) GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID)
 		return nil, fmt.Errorf("GetSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-sks-nodepool")
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-sks-nodepool"); err != nil {
 		return nil, fmt.Errorf("GetSKSNodepool: http response: %w", err)
 	}
 
@@ -12492,6 +10505,30 @@ func (c Client) GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID)
 	return bodyresp, nil
 }
 
+// WaitSKSNodepoolState waits for the SKSNodepool to reach the given state, polling GetSKSNodepool.
+// The wait fails if the SKSNodepool reaches the error state.
+func (c Client) WaitSKSNodepoolState(ctx context.Context, id UUID, sksNodepoolID UUID, state SKSNodepoolState, opts ...WaitOpt) (*SKSNodepool, error) {
+	var resource *SKSNodepool
+	err := c.poll(ctx, "WaitSKSNodepoolState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetSKSNodepool(ctx, id, sksNodepoolID)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == SKSNodepoolStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitSKSNodepoolState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitSKSNodepoolState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 type UpdateSKSNodepoolRequest struct {
 	// Nodepool Anti-affinity Groups
 	AntiAffinityGroups []AntiAffinityGroup `json:"anti-affinity-groups,omitempty"`
@@ -12536,24 +10573,12 @@ func (c Client) UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("UpdateSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-sks-nodepool")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-sks-nodepool"); err != nil {
 		return nil, fmt.Errorf("UpdateSKSNodepool: http response: %w", err)
 	}
 
@@ -12590,24 +10615,12 @@ func (c Client) ResetSKSNodepoolField(ctx context.Context, id UUID, sksNodepoolI
 		return nil, fmt.Errorf("ResetSKSNodepoolField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetSKSNodepoolField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-sks-nodepool-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-sks-nodepool-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetSKSNodepoolField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-sks-nodepool-field"); err != nil {
 		return nil, fmt.Errorf("ResetSKSNodepoolField: http response: %w", err)
 	}
 
@@ -12644,24 +10657,12 @@ func (c Client) EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoo
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EvictSKSNodepoolMembers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "evict-sks-nodepool-members")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "evict-sks-nodepool-members")
 	if err != nil {
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "evict-sks-nodepool-members"); err != nil {
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: http response: %w", err)
 	}
 
@@ -12699,24 +10700,12 @@ func (c Client) ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUI
 		return nil, fmt.Errorf("ScaleSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "scale-sks-nodepool")
 	if err != nil {
 		return nil, fmt.Errorf("ScaleSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "scale-sks-nodepool"); err != nil {
 		return nil, fmt.Errorf("ScaleSKSNodepool: http response: %w", err)
 	}
 
@@ -12742,24 +10731,12 @@ func (c Client) RotateSKSCcmCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSCcmCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-ccm-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "rotate-sks-ccm-credentials")
 	if err != nil {
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "rotate-sks-ccm-credentials"); err != nil {
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: http response: %w", err)
 	}
 
@@ -12785,24 +10762,12 @@ func (c Client) RotateSKSOperatorsCA(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-operators-ca")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "rotate-sks-operators-ca")
 	if err != nil {
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "rotate-sks-operators-ca"); err != nil {
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: http response: %w", err)
 	}
 
@@ -12840,24 +10805,12 @@ func (c Client) UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSCl
 		return nil, fmt.Errorf("UpgradeSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "upgrade-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "upgrade-sks-cluster")
 	if err != nil {
 		return nil, fmt.Errorf("UpgradeSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "upgrade-sks-cluster"); err != nil {
 		return nil, fmt.Errorf("UpgradeSKSCluster: http response: %w", err)
 	}
 
@@ -12883,24 +10836,12 @@ func (c Client) UpgradeSKSClusterServiceLevel(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "upgrade-sks-cluster-service-level")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "upgrade-sks-cluster-service-level")
 	if err != nil {
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "upgrade-sks-cluster-service-level"); err != nil {
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: http response: %w", err)
 	}
 
@@ -12933,24 +10874,12 @@ func (c Client) ResetSKSClusterField(ctx context.Context, id UUID, field ResetSK
 		return nil, fmt.Errorf("ResetSKSClusterField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetSKSClusterField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-sks-cluster-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "reset-sks-cluster-field")
 	if err != nil {
 		return nil, fmt.Errorf("ResetSKSClusterField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "reset-sks-cluster-field"); err != nil {
 		return nil, fmt.Errorf("ResetSKSClusterField: http response: %w", err)
 	}
 
@@ -12991,24 +10920,12 @@ func (c Client) ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, erro
 		return nil, fmt.Errorf("ListSnapshots: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSnapshots: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-snapshots")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-snapshots")
 	if err != nil {
 		return nil, fmt.Errorf("ListSnapshots: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-snapshots"); err != nil {
 		return nil, fmt.Errorf("ListSnapshots: http response: %w", err)
 	}
 
@@ -13020,6 +10937,23 @@ func (c Client) ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, erro
 	return bodyresp, nil
 }
 
+// AllSnapshots returns an iterator over the Snapshot returned by ListSnapshots.
+func (c Client) AllSnapshots(ctx context.Context) iter.Seq2[Snapshot, error] {
+	return func(yield func(Snapshot, error) bool) {
+		resp, err := c.ListSnapshots(ctx)
+		if err != nil {
+			yield(Snapshot{}, err)
+			return
+		}
+
+		for _, elem := range resp.Snapshots {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Delete a Snapshot
 func (c Client) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/snapshot/%v", id)
@@ -13034,24 +10968,12 @@ func (c Client) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-snapshot"); err != nil {
 		return nil, fmt.Errorf("DeleteSnapshot: http response: %w", err)
 	}
 
@@ -13077,24 +10999,12 @@ func (c Client) GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error) {
 		return nil, fmt.Errorf("GetSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("GetSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-snapshot"); err != nil {
 		return nil, fmt.Errorf("GetSnapshot: http response: %w", err)
 	}
 
@@ -13106,6 +11016,30 @@ func (c Client) GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error) {
 	return bodyresp, nil
 }
 
+// WaitSnapshotState waits for the Snapshot to reach the given state, polling GetSnapshot.
+// The wait fails if the Snapshot reaches the error state.
+func (c Client) WaitSnapshotState(ctx context.Context, id UUID, state SnapshotState, opts ...WaitOpt) (*Snapshot, error) {
+	var resource *Snapshot
+	err := c.poll(ctx, "WaitSnapshotState", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		res, err := c.GetSnapshot(ctx, id)
+		if err != nil {
+			return "", nil, false, err
+		}
+		resource = res
+
+		return string(res.State), res, res.State == state || res.State == SnapshotStateError, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitSnapshotState: %w", err)
+	}
+
+	if resource.State != state {
+		return resource, fmt.Errorf("WaitSnapshotState: unexpected state %q", resource.State)
+	}
+
+	return resource, nil
+}
+
 // Export a Snapshot
 func (c Client) ExportSnapshot(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/snapshot/%v:export", id)
@@ -13120,24 +11054,12 @@ func (c Client) ExportSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("ExportSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ExportSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "export-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "export-snapshot")
 	if err != nil {
 		return nil, fmt.Errorf("ExportSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "export-snapshot"); err != nil {
 		return nil, fmt.Errorf("ExportSnapshot: http response: %w", err)
 	}
 
@@ -13183,24 +11105,12 @@ func (c Client) PromoteSnapshotToTemplate(ctx context.Context, id UUID, req Prom
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("PromoteSnapshotToTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "promote-snapshot-to-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "promote-snapshot-to-template")
 	if err != nil {
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "promote-snapshot-to-template"); err != nil {
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: http response: %w", err)
 	}
 
@@ -13230,24 +11140,12 @@ func (c Client) ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageRe
 		return nil, fmt.Errorf("ListSOSBucketsUsage: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSOSBucketsUsage: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sos-buckets-usage")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-sos-buckets-usage")
 	if err != nil {
 		return nil, fmt.Errorf("ListSOSBucketsUsage: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-sos-buckets-usage"); err != nil {
 		return nil, fmt.Errorf("ListSOSBucketsUsage: http response: %w", err)
 	}
 
@@ -13259,6 +11157,23 @@ func (c Client) ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageRe
 	return bodyresp, nil
 }
 
+// AllSOSBucketsUsage returns an iterator over the SOSBucketUsage returned by ListSOSBucketsUsage.
+func (c Client) AllSOSBucketsUsage(ctx context.Context) iter.Seq2[SOSBucketUsage, error] {
+	return func(yield func(SOSBucketUsage, error) bool) {
+		resp, err := c.ListSOSBucketsUsage(ctx)
+		if err != nil {
+			yield(SOSBucketUsage{}, err)
+			return
+		}
+
+		for _, elem := range resp.SOSBucketsUsage {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GetSOSPresignedURLResponse struct {
 	URL string `json:"url,omitempty"`
 }
@@ -13293,24 +11208,12 @@ func (c Client) GetSOSPresignedURL(ctx context.Context, bucket string, opts ...G
 		return nil, fmt.Errorf("GetSOSPresignedURL: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSOSPresignedURL: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sos-presigned-url")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-sos-presigned-url")
 	if err != nil {
 		return nil, fmt.Errorf("GetSOSPresignedURL: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-sos-presigned-url"); err != nil {
 		return nil, fmt.Errorf("GetSOSPresignedURL: http response: %w", err)
 	}
 
@@ -13340,24 +11243,12 @@ func (c Client) ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error) {
 		return nil, fmt.Errorf("ListSSHKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSSHKeys: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-ssh-keys")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-ssh-keys")
 	if err != nil {
 		return nil, fmt.Errorf("ListSSHKeys: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-ssh-keys"); err != nil {
 		return nil, fmt.Errorf("ListSSHKeys: http response: %w", err)
 	}
 
@@ -13369,6 +11260,23 @@ func (c Client) ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error) {
 	return bodyresp, nil
 }
 
+// AllSSHKeys returns an iterator over the SSHKey returned by ListSSHKeys.
+func (c Client) AllSSHKeys(ctx context.Context) iter.Seq2[SSHKey, error] {
+	return func(yield func(SSHKey, error) bool) {
+		resp, err := c.ListSSHKeys(ctx)
+		if err != nil {
+			yield(SSHKey{}, err)
+			return
+		}
+
+		for _, elem := range resp.SSHKeys {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type RegisterSSHKeyRequest struct {
 	// SSH key name
 	Name string `json:"name" validate:"required"`
@@ -13397,24 +11305,12 @@ func (c Client) RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (
 		return nil, fmt.Errorf("RegisterSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RegisterSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "register-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "register-ssh-key")
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSSHKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "register-ssh-key"); err != nil {
 		return nil, fmt.Errorf("RegisterSSHKey: http response: %w", err)
 	}
 
@@ -13440,24 +11336,12 @@ func (c Client) DeleteSSHKey(ctx context.Context, name string) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-ssh-key")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSSHKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-ssh-key"); err != nil {
 		return nil, fmt.Errorf("DeleteSSHKey: http response: %w", err)
 	}
 
@@ -13483,24 +11367,12 @@ func (c Client) GetSSHKey(ctx context.Context, name string) (*SSHKey, error) {
 		return nil, fmt.Errorf("GetSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-ssh-key")
 	if err != nil {
 		return nil, fmt.Errorf("GetSSHKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-ssh-key"); err != nil {
 		return nil, fmt.Errorf("GetSSHKey: http response: %w", err)
 	}
 
@@ -13570,24 +11442,12 @@ func (c Client) ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*L
 		return nil, fmt.Errorf("ListTemplates: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListTemplates: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-templates")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-templates")
 	if err != nil {
 		return nil, fmt.Errorf("ListTemplates: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-templates"); err != nil {
 		return nil, fmt.Errorf("ListTemplates: http response: %w", err)
 	}
 
@@ -13599,6 +11459,23 @@ func (c Client) ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*L
 	return bodyresp, nil
 }
 
+// AllTemplates returns an iterator over the Template returned by ListTemplates.
+func (c Client) AllTemplates(ctx context.Context, opts ...ListTemplatesOpt) iter.Seq2[Template, error] {
+	return func(yield func(Template, error) bool) {
+		resp, err := c.ListTemplates(ctx, opts...)
+		if err != nil {
+			yield(Template{}, err)
+			return
+		}
+
+		for _, elem := range resp.Templates {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
+
 type RegisterTemplateRequestBootMode string
 
 const (
@@ -13654,24 +11531,12 @@ func (c Client) RegisterTemplate(ctx context.Context, req RegisterTemplateReques
 		return nil, fmt.Errorf("RegisterTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RegisterTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "register-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "register-template")
 	if err != nil {
 		return nil, fmt.Errorf("RegisterTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "register-template"); err != nil {
 		return nil, fmt.Errorf("RegisterTemplate: http response: %w", err)
 	}
 
@@ -13697,24 +11562,12 @@ func (c Client) DeleteTemplate(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "delete-template")
 	if err != nil {
 		return nil, fmt.Errorf("DeleteTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "delete-template"); err != nil {
 		return nil, fmt.Errorf("DeleteTemplate: http response: %w", err)
 	}
 
@@ -13740,24 +11593,12 @@ func (c Client) GetTemplate(ctx context.Context, id UUID) (*Template, error) {
 		return nil, fmt.Errorf("GetTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "get-template")
 	if err != nil {
 		return nil, fmt.Errorf("GetTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "get-template"); err != nil {
 		return nil, fmt.Errorf("GetTemplate: http response: %w", err)
 	}
 
@@ -13795,24 +11636,12 @@ func (c Client) CopyTemplate(ctx context.Context, id UUID, req CopyTemplateReque
 		return nil, fmt.Errorf("CopyTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CopyTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "copy-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "copy-template")
 	if err != nil {
 		return nil, fmt.Errorf("CopyTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "copy-template"); err != nil {
 		return nil, fmt.Errorf("CopyTemplate: http response: %w", err)
 	}
 
@@ -13852,24 +11681,12 @@ func (c Client) UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateR
 		return nil, fmt.Errorf("UpdateTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "update-template")
 	if err != nil {
 		return nil, fmt.Errorf("UpdateTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "update-template"); err != nil {
 		return nil, fmt.Errorf("UpdateTemplate: http response: %w", err)
 	}
 
@@ -13899,24 +11716,12 @@ func (c Client) ListZones(ctx context.Context) (*ListZonesResponse, error) {
 		return nil, fmt.Errorf("ListZones: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListZones: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-zones")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, request, "list-zones")
 	if err != nil {
 		return nil, fmt.Errorf("ListZones: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
-	if err := handleHTTPErrorResp(response); err != nil {
+	if err := handleHTTPErrorResp(response, "list-zones"); err != nil {
 		return nil, fmt.Errorf("ListZones: http response: %w", err)
 	}
 
@@ -13927,3 +11732,20 @@ func (c Client) ListZones(ctx context.Context) (*ListZonesResponse, error) {
 
 	return bodyresp, nil
 }
+
+// AllZones returns an iterator over the Zone returned by ListZones.
+func (c Client) AllZones(ctx context.Context) iter.Seq2[Zone, error] {
+	return func(yield func(Zone, error) bool) {
+		resp, err := c.ListZones(ctx)
+		if err != nil {
+			yield(Zone{}, err)
+			return
+		}
+
+		for _, elem := range resp.Zones {
+			if !yield(elem, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Package otelegoscale provides an OpenTelemetry instrumentation of the
+// Exoscale API v3 client, recording a span per API operation and wait, along
+// with latency and error metrics.
+//
+// It is a separate module so that the v3 client does not depend on OpenTelemetry:
+//
+//	instrumentation, err := otelegoscale.New()
+//	if err != nil {
+//		return err
+//	}
+//	client, err := v3.NewClient(creds, v3.ClientOptWithInstrumentation(instrumentation))
+package otelegoscale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// ScopeName is the instrumentation scope name.
+const ScopeName = "github.com/sauterp/egoscale/v3/otelegoscale"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	OperationIDKey = attribute.Key("exoscale.operation.id")
+	ZoneKey        = attribute.Key("exoscale.zone")
+	RetriesKey     = attribute.Key("exoscale.retries")
+	WaitNameKey    = attribute.Key("exoscale.wait.name")
+	WaitPollsKey   = attribute.Key("exoscale.wait.polls")
+	WaitStateKey   = attribute.Key("exoscale.wait.state")
+
+	httpMethodKey     = attribute.Key("http.request.method")
+	httpStatusCodeKey = attribute.Key("http.response.status_code")
+	urlFullKey        = attribute.Key("url.full")
+	serverAddressKey  = attribute.Key("server.address")
+	errorTypeKey      = attribute.Key("error.type")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option represents a function setting an Instrumentation option.
+type Option func(*config)
+
+// WithTracerProvider returns an Option overriding the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider returns an Option overriding the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// WithPropagator returns an Option overriding the global TextMapPropagator,
+// used to propagate the trace context in the API requests headers.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// Instrumentation implements v3.Instrumentation with OpenTelemetry.
+type Instrumentation struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	requestDuration metric.Float64Histogram
+	requestErrors   metric.Int64Counter
+	waitDuration    metric.Float64Histogram
+}
+
+var _ v3.Instrumentation = (*Instrumentation)(nil)
+
+// New returns an Instrumentation using the global OpenTelemetry providers,
+// unless overridden by options.
+func New(opts ...Option) (*Instrumentation, error) {
+	c := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	meter := c.meterProvider.Meter(ScopeName)
+	i := &Instrumentation{
+		tracer:     c.tracerProvider.Tracer(ScopeName),
+		propagator: c.propagator,
+	}
+
+	var err error
+	i.requestDuration, err = meter.Float64Histogram(
+		"exoscale.api.request.duration",
+		metric.WithDescription("Duration of the Exoscale API operations, retries included."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("otelegoscale: %w", err)
+	}
+
+	i.requestErrors, err = meter.Int64Counter(
+		"exoscale.api.request.errors",
+		metric.WithDescription("Number of failed Exoscale API operations."),
+		metric.WithUnit("{operation}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("otelegoscale: %w", err)
+	}
+
+	i.waitDuration, err = meter.Float64Histogram(
+		"exoscale.api.wait.duration",
+		metric.WithDescription("Duration of the waits for Exoscale async operations and resource states."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("otelegoscale: %w", err)
+	}
+
+	return i, nil
+}
+
+// StartRequest starts a client span named after the API operation ID.
+func (i *Instrumentation) StartRequest(ctx context.Context, info v3.RequestInfo) (context.Context, func(v3.RequestResult)) {
+	attrs := []attribute.KeyValue{OperationIDKey.String(info.OperationID)}
+	if info.Zone != "" {
+		attrs = append(attrs, ZoneKey.String(string(info.Zone)))
+	}
+
+	spanAttrs := attrs
+	if info.Request != nil {
+		spanAttrs = append(spanAttrs,
+			httpMethodKey.String(info.Request.Method),
+			urlFullKey.String(info.Request.URL.String()),
+			serverAddressKey.String(info.Request.URL.Hostname()),
+		)
+	}
+
+	ctx, span := i.tracer.Start(ctx, info.OperationID,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(spanAttrs...),
+	)
+	if info.Request != nil {
+		i.propagator.Inject(ctx, propagation.HeaderCarrier(info.Request.Header))
+	}
+
+	return ctx, func(res v3.RequestResult) {
+		defer span.End()
+
+		span.SetAttributes(RetriesKey.Int(res.Retries))
+		if res.StatusCode != 0 {
+			span.SetAttributes(httpStatusCodeKey.Int(res.StatusCode))
+			attrs = append(attrs, httpStatusCodeKey.Int(res.StatusCode))
+		}
+
+		switch {
+		case res.Err != nil:
+			span.RecordError(res.Err)
+			span.SetStatus(codes.Error, res.Err.Error())
+			attrs = append(attrs, errorTypeKey.String(fmt.Sprintf("%T", res.Err)))
+		case res.StatusCode >= http.StatusBadRequest:
+			span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+			attrs = append(attrs, errorTypeKey.String(fmt.Sprint(res.StatusCode)))
+		}
+
+		set := metric.WithAttributeSet(attribute.NewSet(attrs...))
+		i.requestDuration.Record(ctx, res.Duration.Seconds(), set)
+		if res.Err != nil || res.StatusCode >= http.StatusBadRequest {
+			i.requestErrors.Add(ctx, 1, set)
+		}
+	}
+}
+
+// StartWait starts a span named after the waiting method.
+func (i *Instrumentation) StartWait(ctx context.Context, info v3.WaitInfo) (context.Context, func(v3.WaitResult)) {
+	attrs := []attribute.KeyValue{WaitNameKey.String(info.Name)}
+	if info.Zone != "" {
+		attrs = append(attrs, ZoneKey.String(string(info.Zone)))
+	}
+
+	ctx, span := i.tracer.Start(ctx, info.Name, trace.WithAttributes(attrs...))
+
+	return ctx, func(res v3.WaitResult) {
+		defer span.End()
+
+		span.SetAttributes(WaitPollsKey.Int(res.Polls), WaitStateKey.String(res.State))
+		if res.Err != nil {
+			span.RecordError(res.Err)
+			span.SetStatus(codes.Error, res.Err.Error())
+			attrs = append(attrs, errorTypeKey.String(fmt.Sprintf("%T", res.Err)))
+		}
+
+		i.waitDuration.Record(ctx, res.Duration.Seconds(), metric.WithAttributeSet(attribute.NewSet(attrs...)))
+	}
+}
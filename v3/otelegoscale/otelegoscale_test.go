@@ -0,0 +1,99 @@
+package otelegoscale
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+func TestInstrumentation(t *testing.T) {
+	var traceparent string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/zone":
+			traceparent = r.Header.Get("Traceparent")
+			_, _ = w.Write([]byte(`{"zones":[]}`))
+		case "/operation/op":
+			_, _ = w.Write([]byte(`{"id":"op","state":"success"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	instrumentation, err := New(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		WithPropagator(propagation.TraceContext{}),
+	)
+	require.NoError(t, err)
+
+	client, err := v3.NewClient(
+		credentials.NewStaticCredentials("EXOtest", "secret"),
+		v3.ClientOptWithEndpoint(v3.Endpoint(ts.URL)),
+		v3.ClientOptWithInstrumentation(instrumentation),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = client.ListZones(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, traceparent)
+
+	_, err = client.GetInstance(ctx, "missing")
+	require.ErrorIs(t, err, v3.ErrNotFound)
+
+	_, err = client.WaitOperation(ctx, &v3.Operation{ID: "op", State: v3.OperationStatePending},
+		v3.WaitOptWithInterval(time.Millisecond, time.Millisecond))
+	require.NoError(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 4)
+	require.Equal(t, "list-zones", ended[0].Name())
+	require.Equal(t, "get-instance", ended[1].Name())
+	require.Equal(t, codes.Error, ended[1].Status().Code)
+	require.Equal(t, "get-operation", ended[2].Name())
+	require.Equal(t, "WaitOperation", ended[3].Name())
+	require.Equal(t, ended[3].SpanContext().SpanID(), ended[2].Parent().SpanID())
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	counts := map[string]int64{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Histogram[float64]:
+			for _, dp := range data.DataPoints {
+				counts[m.Name] += int64(dp.Count)
+			}
+		case metricdata.Sum[int64]:
+			for _, dp := range data.DataPoints {
+				counts[m.Name] += dp.Value
+			}
+		}
+	}
+	require.Equal(t, map[string]int64{
+		"exoscale.api.request.duration": 3,
+		"exoscale.api.request.errors":   1,
+		"exoscale.api.wait.duration":    1,
+	}, counts)
+}
@@ -0,0 +1,228 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit represents the client-side limits applied by a Client to its API
+// requests, retries included, to stay below the API throttling thresholds.
+//
+// The rates are enforced by token buckets per zone endpoint, shared by all the
+// Client copies returned by WithEndpoint and the likes.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of requests per zone endpoint.
+	// If MutationsPerSecond is set, it only applies to the read requests.
+	// No rate is enforced if zero.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests which can be sent at once above
+	// RequestsPerSecond, 1 if unset.
+	Burst int
+
+	// MutationsPerSecond is the sustained rate of mutating requests (POST, PUT,
+	// PATCH and DELETE) per zone endpoint, limited separately from the read
+	// requests if set.
+	MutationsPerSecond float64
+
+	// MutationBurst is the number of mutating requests which can be sent at once
+	// above MutationsPerSecond, 1 if unset.
+	MutationBurst int
+
+	// MaxInFlight is the maximum number of concurrent requests across all the
+	// zone endpoints. No limit is enforced if zero.
+	MaxInFlight int
+
+	// ThrottleDelay is the delay for which an endpoint stops sending requests of
+	// the same class after an HTTP 429 response without a Retry-After header,
+	// 1 second if unset.
+	ThrottleDelay time.Duration
+}
+
+// rateLimitKey identifies a token bucket.
+type rateLimitKey struct {
+	endpoint string
+	mutation bool
+}
+
+// rateLimiter enforces a RateLimit. It is safe for concurrent use.
+type rateLimiter struct {
+	limit    RateLimit
+	inFlight chan struct{}
+
+	mu      sync.Mutex
+	buckets map[rateLimitKey]*tokenBucket
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	if limit.Burst == 0 {
+		limit.Burst = 1
+	}
+	if limit.MutationBurst == 0 {
+		limit.MutationBurst = 1
+	}
+	if limit.ThrottleDelay == 0 {
+		limit.ThrottleDelay = time.Second
+	}
+
+	l := &rateLimiter{
+		limit:   limit,
+		buckets: make(map[rateLimitKey]*tokenBucket),
+	}
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+
+	return l
+}
+
+// bucket returns the token bucket of the requests with the given method to the
+// given endpoint.
+func (l *rateLimiter) bucket(endpoint, method string) *tokenBucket {
+	key := rateLimitKey{endpoint: endpoint}
+	if l.limit.MutationsPerSecond > 0 {
+		key.mutation = isMutation(method)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{rate: l.limit.RequestsPerSecond, burst: float64(l.limit.Burst)}
+		if key.mutation {
+			b = &tokenBucket{rate: l.limit.MutationsPerSecond, burst: float64(l.limit.MutationBurst)}
+		}
+		b.tokens = b.burst
+		b.last = time.Now()
+		l.buckets[key] = b
+	}
+
+	return b
+}
+
+// acquire blocks until a request with the given method can be sent to the given
+// endpoint, and returns the function to call once the response is received.
+// A nil rateLimiter never blocks.
+func (l *rateLimiter) acquire(ctx context.Context, endpoint, method string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	b := l.bucket(endpoint, method)
+	if delay := b.reserve(time.Now()); delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			b.cancel()
+			return nil, ctx.Err()
+		}
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.inFlight <- struct{}{}:
+		return func() { <-l.inFlight }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// throttled pauses the requests with the given method to the given endpoint
+// after the API throttled one of them.
+func (l *rateLimiter) throttled(endpoint, method string, resp *http.Response) {
+	if l == nil {
+		return
+	}
+
+	delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+	if !ok {
+		delay = l.limit.ThrottleDelay
+	}
+
+	now := time.Now()
+	l.bucket(endpoint, method).pause(now, now.Add(delay))
+}
+
+// isMutation returns whether an HTTP method mutates resources.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// tokenBucket is a token bucket refilled at a constant rate, which can be
+// paused. A zero rate means no rate is enforced.
+type tokenBucket struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// reserve takes a token from the bucket and returns the delay to wait before
+// sending the request.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delay := max(b.pausedUntil.Sub(now), 0)
+	if b.rate <= 0 {
+		return delay
+	}
+
+	b.refill(now)
+	b.tokens--
+	if b.tokens < 0 {
+		ready := b.last.Add(time.Duration(-b.tokens / b.rate * float64(time.Second)))
+		delay = max(delay, ready.Sub(now))
+	}
+
+	return delay
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (b *tokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// cancel gives back a token reserved by a request which was not sent.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate > 0 {
+		b.tokens = min(b.burst, b.tokens+1)
+	}
+}
+
+// pause prevents the requests from being sent before the given time, and
+// empties the bucket so that they resume at the sustained rate.
+func (b *tokenBucket) pause(now, until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+	if b.rate > 0 && until.After(b.last) {
+		b.refill(now)
+		b.tokens = min(b.tokens, 0)
+		b.last = until
+	}
+}
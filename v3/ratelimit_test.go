@@ -0,0 +1,116 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := &tokenBucket{rate: 10, burst: 2, tokens: 2, last: now}
+
+	require.Equal(t, time.Duration(0), b.reserve(now))
+	require.Equal(t, time.Duration(0), b.reserve(now))
+	require.Equal(t, 100*time.Millisecond, b.reserve(now))
+	require.Equal(t, 200*time.Millisecond, b.reserve(now))
+
+	b.cancel()
+	require.Equal(t, 200*time.Millisecond, b.reserve(now))
+
+	// Refilled up to the burst only.
+	now = now.Add(time.Second)
+	require.Equal(t, time.Duration(0), b.reserve(now))
+	require.Equal(t, time.Duration(0), b.reserve(now))
+	require.Equal(t, 100*time.Millisecond, b.reserve(now))
+
+	// Paused, then resumed at the sustained rate.
+	now = now.Add(time.Second)
+	b.pause(now, now.Add(time.Second))
+	require.Equal(t, 1100*time.Millisecond, b.reserve(now))
+	require.Equal(t, 1200*time.Millisecond, b.reserve(now))
+
+	unlimited := &tokenBucket{}
+	require.Equal(t, time.Duration(0), unlimited.reserve(now))
+	unlimited.pause(now, now.Add(time.Second))
+	require.Equal(t, time.Second, unlimited.reserve(now))
+}
+
+func TestClientRateLimit(t *testing.T) {
+	var (
+		inFlight, maxInFlight atomic.Int32
+		throttle              atomic.Bool
+		mu                    sync.Mutex
+		sent                  []time.Time
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		mu.Lock()
+		sent = append(sent, time.Now())
+		mu.Unlock()
+
+		if throttle.CompareAndSwap(true, false) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(
+		credentials.NewStaticCredentials("EXOtest", "secret"),
+		ClientOptWithEndpoint(Endpoint(ts.URL)),
+		ClientOptWithRetryPolicy(RetryPolicy{MaxRetries: 1, StatusCodes: []int{http.StatusTooManyRequests}}),
+		ClientOptWithRateLimit(RateLimit{MaxInFlight: 2, ThrottleDelay: 100 * time.Millisecond}),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = client.WithEndpoint(Endpoint(ts.URL)).ListZones(ctx)
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(2), maxInFlight.Load())
+
+	sent = nil
+	throttle.Store(true)
+	_, err = client.ListZones(ctx)
+	require.NoError(t, err)
+	require.Len(t, sent, 2)
+	require.GreaterOrEqual(t, sent[1].Sub(sent[0]), 100*time.Millisecond)
+
+	_, err = NewClient(
+		credentials.NewStaticCredentials("EXOtest", "secret"),
+		ClientOptWithRateLimit(RateLimit{RequestsPerSecond: -1}),
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package v3
+
+import (
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy represents the policy used by the Client to retry failed HTTP requests.
+//
+// Only idempotent requests (GET, HEAD, OPTIONS, PUT, DELETE) are retried on
+// retryable status codes and connection errors. HTTP 429 responses are retried
+// regardless of the request method, since the API rejects throttled requests
+// before processing them.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the initial attempt.
+	MaxRetries int
+
+	// MinWait is the base delay of the exponential backoff between two attempts.
+	MinWait time.Duration
+
+	// MaxWait is the maximum delay of the exponential backoff between two attempts.
+	// A delay requested by the API via the Retry-After header takes precedence.
+	MaxWait time.Duration
+
+	// StatusCodes is the list of HTTP response status codes considered retryable.
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy returns a RetryPolicy retrying requests up to 4 times on
+// HTTP 429, 502, 503 and 504 responses and connection resets.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 4,
+		MinWait:    500 * time.Millisecond,
+		MaxWait:    30 * time.Second,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// shouldRetry returns true and the delay to wait before the next attempt if
+// the request must be retried, given the outcome of the previous attempt.
+func (p *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The request body cannot be replayed.
+		return 0, false
+	}
+
+	if err != nil {
+		if req.Context().Err() != nil || !isIdempotent(req.Method) || !isRetryableError(err) {
+			return 0, false
+		}
+
+		return p.backoff(attempt), true
+	}
+
+	if !slices.Contains(p.StatusCodes, resp.StatusCode) {
+		return 0, false
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests && !isIdempotent(req.Method) {
+		return 0, false
+	}
+
+	wait := p.backoff(attempt)
+	if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok && retryAfter > wait {
+		wait = retryAfter
+	}
+
+	return wait, true
+}
+
+// backoff returns the jittered exponential delay to wait after the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.MinWait << attempt
+	if wait <= 0 || (p.MaxWait > 0 && wait > p.MaxWait) {
+		wait = p.MaxWait
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Keep half of the delay and randomize the other half to spread retries of
+	// concurrent clients over time.
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// parseRetryAfter parses a Retry-After header value, expressed either in
+// seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func isRetryableError(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
@@ -0,0 +1,94 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+func newTestClient(t *testing.T, url string, opts ...ClientOpt) *Client {
+	t.Helper()
+
+	opts = append([]ClientOpt{ClientOptWithEndpoint(Endpoint(url))}, opts...)
+	client, err := NewClient(credentials.NewStaticCredentials("EXOtest", "secret"), opts...)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestClientRetry(t *testing.T) {
+	var (
+		attempts   int
+		signatures []string
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		signatures = append(signatures, r.Header.Get("Authorization"))
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2"}]}`))
+	}))
+	defer ts.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.MinWait = time.Millisecond
+	client := newTestClient(t, ts.URL, ClientOptWithRetryPolicy(policy))
+
+	resp, err := client.ListZones(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ZoneName("ch-gva-2"), resp.Zones[0].Name)
+	require.Equal(t, 3, attempts)
+	require.Len(t, signatures, 3)
+	require.NotEmpty(t, signatures[2])
+}
+
+func TestClientRetryNonIdempotent(t *testing.T) {
+	var (
+		attempts int
+		bodies   []int64
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		bodies = append(bodies, r.ContentLength)
+		switch attempts {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.MinWait = time.Millisecond
+	client := newTestClient(t, ts.URL, ClientOptWithRetryPolicy(policy))
+
+	_, err := client.CreateAntiAffinityGroup(context.Background(), CreateAntiAffinityGroupRequest{Name: "test"})
+	require.ErrorIs(t, err, ErrAPIError)
+	// 429 is retried for any method, 503 only for idempotent ones.
+	require.Equal(t, 2, attempts)
+	require.Equal(t, bodies[0], bodies[1])
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("120")
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, d)
+
+	_, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	require.True(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	require.False(t, ok)
+}
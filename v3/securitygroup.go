@@ -0,0 +1,321 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// SecurityGroupRulesPlan describes the changes needed to make a Security Group
+// match a desired set of rules and external sources.
+type SecurityGroupRulesPlan struct {
+	// AddRules are the desired rules missing from the Security Group.
+	AddRules []SecurityGroupRule
+	// DeleteRules are the Security Group rules not desired.
+	DeleteRules []SecurityGroupRule
+	// AddExternalSources are the desired external sources missing from the Security Group.
+	AddExternalSources []string
+	// RemoveExternalSources are the Security Group external sources not desired.
+	RemoveExternalSources []string
+}
+
+// Empty returns whether the Security Group already matches the desired state.
+func (p *SecurityGroupRulesPlan) Empty() bool {
+	return len(p.AddRules) == 0 && len(p.DeleteRules) == 0 &&
+		len(p.AddExternalSources) == 0 && len(p.RemoveExternalSources) == 0
+}
+
+// SyncSecurityGroupRulesOpt represents a function setting a SyncSecurityGroupRules option.
+type SyncSecurityGroupRulesOpt func(*syncSecurityGroupRulesOptions)
+
+type syncSecurityGroupRulesOptions struct {
+	dryRun          bool
+	externalSources []string
+}
+
+// SyncSecurityGroupRulesOptWithDryRun returns a SyncSecurityGroupRulesOpt
+// computing the plan without applying it.
+func SyncSecurityGroupRulesOptWithDryRun() SyncSecurityGroupRulesOpt {
+	return func(o *syncSecurityGroupRulesOptions) {
+		o.dryRun = true
+	}
+}
+
+// SyncSecurityGroupRulesOptWithExternalSources returns a SyncSecurityGroupRulesOpt
+// also reconciling the Security Group external sources with the given CIDRs.
+// The external sources are left untouched otherwise.
+func SyncSecurityGroupRulesOptWithExternalSources(cidrs ...string) SyncSecurityGroupRulesOpt {
+	return func(o *syncSecurityGroupRulesOptions) {
+		o.externalSources = append([]string{}, cidrs...)
+	}
+}
+
+// SyncSecurityGroupRules makes the rules of a Security Group exactly match the
+// desired ones, and returns the applied plan.
+//
+// Rules are identified by their direction, protocol, port range, ICMP type and
+// code, and network or referenced Security Group, once normalized: a single port
+// is a range of one port, networks are canonical CIDRs, and a referenced
+// Security Group is matched by ID if set, by name otherwise. The description is
+// not part of the identity, hence not reconciled.
+//
+// Missing rules and external sources are added before the extra ones are
+// removed, so that the traffic allowed by both states is never interrupted.
+func (c Client) SyncSecurityGroupRules(ctx context.Context, groupID UUID, desired []SecurityGroupRule, opts ...SyncSecurityGroupRulesOpt) (*SecurityGroupRulesPlan, error) {
+	o := &syncSecurityGroupRulesOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	sg, err := c.GetSecurityGroup(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+	}
+
+	plan, err := planSecurityGroupRules(sg, desired, o.externalSources)
+	if err != nil {
+		return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+	}
+	if o.dryRun || plan.Empty() {
+		return plan, nil
+	}
+
+	for _, cidr := range plan.AddExternalSources {
+		op, err := c.AddExternalSourceToSecurityGroup(ctx, groupID, AddExternalSourceToSecurityGroupRequest{Cidr: cidr})
+		if err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+	}
+
+	for _, rule := range plan.AddRules {
+		op, err := c.AddRuleToSecurityGroup(ctx, groupID, addRuleRequest(rule))
+		if err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+	}
+
+	for _, rule := range plan.DeleteRules {
+		op, err := c.DeleteRuleFromSecurityGroup(ctx, groupID, rule.ID)
+		if err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+	}
+
+	for _, cidr := range plan.RemoveExternalSources {
+		op, err := c.RemoveExternalSourceFromSecurityGroup(ctx, groupID, RemoveExternalSourceFromSecurityGroupRequest{Cidr: cidr})
+		if err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return nil, fmt.Errorf("SyncSecurityGroupRules: %w", err)
+		}
+	}
+
+	return plan, nil
+}
+
+// planSecurityGroupRules computes the changes needed to make a Security Group
+// match the desired rules, and external sources if not nil.
+func planSecurityGroupRules(sg *SecurityGroup, desired []SecurityGroupRule, externalSources []string) (*SecurityGroupRulesPlan, error) {
+	plan := &SecurityGroupRulesPlan{}
+
+	// Existing rules are indexed by both the ID and the name of the Security
+	// Group they reference, since desired rules can use either.
+	existing := make(map[string]int)
+	for i, rule := range sg.Rules {
+		keys, err := securityGroupRuleKeys(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.ID, err)
+		}
+		for _, key := range keys {
+			existing[key] = i
+		}
+	}
+
+	kept := make(map[int]bool)
+	planned := make(map[string]bool)
+	for _, rule := range desired {
+		keys, err := securityGroupRuleKeys(rule)
+		if err != nil {
+			return nil, err
+		}
+		key := keys[0]
+		if planned[key] {
+			continue
+		}
+		planned[key] = true
+
+		if i, ok := existing[key]; ok {
+			kept[i] = true
+			continue
+		}
+		plan.AddRules = append(plan.AddRules, rule)
+	}
+
+	for i, rule := range sg.Rules {
+		if !kept[i] {
+			plan.DeleteRules = append(plan.DeleteRules, rule)
+		}
+	}
+
+	if externalSources != nil {
+		current := make(map[string]string)
+		for _, cidr := range sg.ExternalSources {
+			normalized, err := normalizeCIDR(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("external source %q: %w", cidr, err)
+			}
+			current[normalized] = cidr
+		}
+
+		wanted := make(map[string]bool)
+		for _, cidr := range externalSources {
+			normalized, err := normalizeCIDR(cidr)
+			if err != nil {
+				return nil, fmt.Errorf("external source %q: %w", cidr, err)
+			}
+			if wanted[normalized] {
+				continue
+			}
+			wanted[normalized] = true
+			if _, ok := current[normalized]; !ok {
+				plan.AddExternalSources = append(plan.AddExternalSources, normalized)
+			}
+		}
+
+		for normalized, cidr := range current {
+			if !wanted[normalized] {
+				plan.RemoveExternalSources = append(plan.RemoveExternalSources, cidr)
+			}
+		}
+		slices.Sort(plan.RemoveExternalSources)
+	}
+
+	return plan, nil
+}
+
+// securityGroupRuleKeys returns the identity keys of a normalized rule. The first
+// key references the Security Group by ID if set, the second one by name.
+func securityGroupRuleKeys(rule SecurityGroupRule) ([]string, error) {
+	direction := strings.ToLower(string(rule.FlowDirection))
+	if direction == "" {
+		return nil, fmt.Errorf("%w: missing flow direction", ErrInvalidRequest)
+	}
+	protocol := strings.ToLower(string(rule.Protocol))
+	if protocol == "" {
+		return nil, fmt.Errorf("%w: missing protocol", ErrInvalidRequest)
+	}
+
+	var ports string
+	switch SecurityGroupRuleProtocol(protocol) {
+	case SecurityGroupRuleProtocolTCP, SecurityGroupRuleProtocolUDP:
+		start, end := normalizePortRange(rule.StartPort, rule.EndPort)
+		if start > end {
+			return nil, fmt.Errorf("%w: start port %d greater than end port %d", ErrInvalidRequest, start, end)
+		}
+		ports = fmt.Sprintf("%d-%d", start, end)
+	case SecurityGroupRuleProtocolICMP, SecurityGroupRuleProtocolIcmpv6:
+		var icmp SecurityGroupRuleICMP
+		if rule.ICMP != nil {
+			icmp = *rule.ICMP
+		}
+		ports = fmt.Sprintf("type=%d,code=%d", icmp.Type, icmp.Code)
+	}
+
+	prefix := direction + "/" + protocol + "/" + ports + "/"
+
+	switch {
+	case rule.Network != "" && rule.SecurityGroup != nil:
+		return nil, fmt.Errorf("%w: both network and security group set", ErrInvalidRequest)
+	case rule.Network != "":
+		network, err := normalizeCIDR(rule.Network)
+		if err != nil {
+			return nil, err
+		}
+		return []string{prefix + "network=" + network}, nil
+	case rule.SecurityGroup != nil:
+		var keys []string
+		if rule.SecurityGroup.ID != "" {
+			keys = append(keys, prefix+"security-group-id="+rule.SecurityGroup.ID.String())
+		}
+		if rule.SecurityGroup.Name != "" {
+			keys = append(keys, prefix+"security-group-name="+rule.SecurityGroup.Name)
+		}
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("%w: security group without ID nor name", ErrInvalidRequest)
+		}
+		return keys, nil
+	default:
+		return nil, fmt.Errorf("%w: missing network or security group", ErrInvalidRequest)
+	}
+}
+
+// normalizePortRange returns the port range of a TCP or UDP rule, a single
+// port being a range of one port and a rule without ports covering all of them.
+func normalizePortRange(start, end int64) (int64, int64) {
+	switch {
+	case start == 0 && end == 0:
+		return 1, 65535
+	case start == 0:
+		return end, end
+	case end == 0:
+		return start, start
+	}
+
+	return start, end
+}
+
+// normalizeCIDR returns the canonical form of a CIDR, a single IP address
+// being a /32 or /128 network.
+func normalizeCIDR(cidr string) (string, error) {
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return "", fmt.Errorf("%w: invalid network %q", ErrInvalidRequest, cidr)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid network %q", ErrInvalidRequest, cidr)
+	}
+
+	return network.String(), nil
+}
+
+func addRuleRequest(rule SecurityGroupRule) AddRuleToSecurityGroupRequest {
+	req := AddRuleToSecurityGroupRequest{
+		Description:   rule.Description,
+		FlowDirection: AddRuleToSecurityGroupRequestFlowDirection(strings.ToLower(string(rule.FlowDirection))),
+		Protocol:      AddRuleToSecurityGroupRequestProtocol(strings.ToLower(string(rule.Protocol))),
+		Network:       rule.Network,
+		SecurityGroup: rule.SecurityGroup,
+	}
+	switch SecurityGroupRuleProtocol(req.Protocol) {
+	case SecurityGroupRuleProtocolTCP, SecurityGroupRuleProtocolUDP:
+		req.StartPort, req.EndPort = normalizePortRange(rule.StartPort, rule.EndPort)
+	}
+	if network, err := normalizeCIDR(rule.Network); err == nil {
+		req.Network = network
+	}
+	if rule.ICMP != nil {
+		req.ICMP = &AddRuleToSecurityGroupRequestICMP{Code: rule.ICMP.Code, Type: rule.ICMP.Type}
+	}
+
+	return req
+}
@@ -0,0 +1,134 @@
+package v3_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+func TestSyncSecurityGroupRules(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	web, _, err := client.EnsureSecurityGroup(ctx, v3.CreateSecurityGroupRequest{Name: "web"})
+	require.NoError(t, err)
+	lb, _, err := client.EnsureSecurityGroup(ctx, v3.CreateSecurityGroupRequest{Name: "lb"})
+	require.NoError(t, err)
+
+	desired := []v3.SecurityGroupRule{
+		{
+			FlowDirection: v3.SecurityGroupRuleFlowDirectionIngress,
+			Protocol:      v3.SecurityGroupRuleProtocolTCP,
+			StartPort:     22,
+			Network:       "192.0.2.0/24",
+		},
+		{
+			FlowDirection: v3.SecurityGroupRuleFlowDirectionIngress,
+			Protocol:      v3.SecurityGroupRuleProtocolTCP,
+			StartPort:     80,
+			EndPort:       80,
+			SecurityGroup: &v3.SecurityGroupResource{Name: "lb"},
+		},
+		{
+			FlowDirection: v3.SecurityGroupRuleFlowDirectionIngress,
+			Protocol:      v3.SecurityGroupRuleProtocolICMP,
+			ICMP:          &v3.SecurityGroupRuleICMP{Type: 8},
+			Network:       "0.0.0.0/0",
+		},
+	}
+
+	plan, err := client.SyncSecurityGroupRules(ctx, web.ID, desired,
+		v3.SyncSecurityGroupRulesOptWithExternalSources("198.51.100.1"),
+		v3.SyncSecurityGroupRulesOptWithDryRun(),
+	)
+	require.NoError(t, err)
+	require.Len(t, plan.AddRules, 3)
+	require.Equal(t, []string{"198.51.100.1/32"}, plan.AddExternalSources)
+
+	sg, err := client.GetSecurityGroup(ctx, web.ID)
+	require.NoError(t, err)
+	require.Empty(t, sg.Rules, "a dry run must not apply the plan")
+
+	_, err = client.SyncSecurityGroupRules(ctx, web.ID, desired,
+		v3.SyncSecurityGroupRulesOptWithExternalSources("198.51.100.1"))
+	require.NoError(t, err)
+
+	// The same rules written differently are left untouched.
+	equivalent := []v3.SecurityGroupRule{
+		{
+			FlowDirection: "INGRESS",
+			Protocol:      "TCP",
+			StartPort:     22,
+			EndPort:       22,
+			Network:       "192.0.2.17/24",
+		},
+		{
+			FlowDirection: v3.SecurityGroupRuleFlowDirectionIngress,
+			Protocol:      v3.SecurityGroupRuleProtocolTCP,
+			EndPort:       80,
+			SecurityGroup: &v3.SecurityGroupResource{ID: lb.ID},
+		},
+		desired[2],
+		desired[2],
+	}
+	plan, err = client.SyncSecurityGroupRules(ctx, web.ID, equivalent,
+		v3.SyncSecurityGroupRulesOptWithExternalSources("198.51.100.1/32"))
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+
+	// Rules and sources no longer desired are removed after the additions.
+	plan, err = client.SyncSecurityGroupRules(ctx, web.ID, []v3.SecurityGroupRule{
+		desired[0],
+		{
+			FlowDirection: v3.SecurityGroupRuleFlowDirectionIngress,
+			Protocol:      v3.SecurityGroupRuleProtocolTCP,
+			StartPort:     443,
+			SecurityGroup: &v3.SecurityGroupResource{Name: "lb"},
+		},
+	}, v3.SyncSecurityGroupRulesOptWithExternalSources())
+	require.NoError(t, err)
+	require.Len(t, plan.AddRules, 1)
+	require.Len(t, plan.DeleteRules, 2)
+	require.Equal(t, []string{"198.51.100.1/32"}, plan.RemoveExternalSources)
+
+	sg, err = client.GetSecurityGroup(ctx, web.ID)
+	require.NoError(t, err)
+	require.Len(t, sg.Rules, 2)
+	require.Empty(t, sg.ExternalSources)
+
+	// Without the option, the external sources are left untouched.
+	_, err = client.SyncSecurityGroupRules(ctx, web.ID, nil)
+	require.NoError(t, err)
+	sg, err = client.GetSecurityGroup(ctx, web.ID)
+	require.NoError(t, err)
+	require.Empty(t, sg.Rules)
+
+	// A rule without ports covers all of them.
+	all := v3.SecurityGroupRule{
+		FlowDirection: v3.SecurityGroupRuleFlowDirectionEgress,
+		Protocol:      v3.SecurityGroupRuleProtocolUDP,
+		Network:       "0.0.0.0/0",
+	}
+	_, err = client.SyncSecurityGroupRules(ctx, web.ID, []v3.SecurityGroupRule{all})
+	require.NoError(t, err)
+	sg, err = client.GetSecurityGroup(ctx, web.ID)
+	require.NoError(t, err)
+	require.Len(t, sg.Rules, 1)
+	require.Equal(t, int64(1), sg.Rules[0].StartPort)
+	require.Equal(t, int64(65535), sg.Rules[0].EndPort)
+	plan, err = client.SyncSecurityGroupRules(ctx, web.ID, []v3.SecurityGroupRule{all})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+
+	_, err = client.SyncSecurityGroupRules(ctx, web.ID, []v3.SecurityGroupRule{{Protocol: v3.SecurityGroupRuleProtocolTCP}})
+	require.ErrorIs(t, err, v3.ErrInvalidRequest)
+}
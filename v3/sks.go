@@ -0,0 +1,374 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SKSNodepoolUpgradePlan describes the rolling replacement of the nodes of an
+// SKS Nodepool.
+type SKSNodepoolUpgradePlan struct {
+	ID   UUID   `json:"id"`
+	Name string `json:"name"`
+	// Size is the size of the Nodepool before the upgrade, restored after the
+	// replacement of each batch of nodes.
+	Size int64 `json:"size"`
+	// Nodes are the IDs of the Compute instances remaining to be replaced.
+	Nodes []UUID `json:"nodes"`
+}
+
+// SKSClusterUpgradePlan describes the upgrade of an SKS cluster to a newer
+// Kubernetes version: the upgrade of the control plane, then the replacement of
+// the nodes of each Nodepool.
+//
+// The plan is updated as it is executed, and can be persisted (e.g. encoded as
+// JSON) to resume an interrupted upgrade.
+type SKSClusterUpgradePlan struct {
+	ClusterID   UUID   `json:"cluster-id"`
+	FromVersion string `json:"from-version"`
+	ToVersion   string `json:"to-version"`
+	// DeprecatedResources are the cluster resources using deprecated Kubernetes APIs.
+	DeprecatedResources []SKSClusterDeprecatedResource `json:"deprecated-resources,omitempty"`
+	// ControlPlaneUpgraded is whether the control plane runs the target version.
+	ControlPlaneUpgraded bool                     `json:"control-plane-upgraded"`
+	Nodepools            []SKSNodepoolUpgradePlan `json:"nodepools,omitempty"`
+}
+
+// Done returns whether the upgrade is complete.
+func (p *SKSClusterUpgradePlan) Done() bool {
+	if !p.ControlPlaneUpgraded {
+		return false
+	}
+
+	for _, np := range p.Nodepools {
+		if len(np.Nodes) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PlanSKSClusterUpgradeOpt represents a function setting a PlanSKSClusterUpgrade option.
+type PlanSKSClusterUpgradeOpt func(*planSKSClusterUpgradeOptions)
+
+type planSKSClusterUpgradeOptions struct {
+	allowDeprecatedResources bool
+}
+
+// PlanSKSClusterUpgradeOptWithDeprecatedResources returns a PlanSKSClusterUpgradeOpt
+// allowing the upgrade of a cluster with deprecated resources, which are then
+// reported in the plan instead of failing with ErrDeprecatedResources.
+func PlanSKSClusterUpgradeOptWithDeprecatedResources() PlanSKSClusterUpgradeOpt {
+	return func(o *planSKSClusterUpgradeOptions) {
+		o.allowDeprecatedResources = true
+	}
+}
+
+// PlanSKSClusterUpgrade plans the upgrade of an SKS cluster to a Kubernetes
+// version, which must be an available version newer than the current one, at
+// most one minor version ahead.
+//
+// If the cluster uses deprecated resources, an error wrapping
+// ErrDeprecatedResources is returned unless the
+// PlanSKSClusterUpgradeOptWithDeprecatedResources option is set.
+func (c Client) PlanSKSClusterUpgrade(ctx context.Context, id UUID, version string, opts ...PlanSKSClusterUpgradeOpt) (*SKSClusterUpgradePlan, error) {
+	o := &planSKSClusterUpgradeOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	cluster, err := c.GetSKSCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("PlanSKSClusterUpgrade: %w", err)
+	}
+
+	versions, err := c.ListSKSClusterVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("PlanSKSClusterUpgrade: %w", err)
+	}
+	if !slices.Contains(versions.SKSClusterVersions, version) {
+		return nil, fmt.Errorf("PlanSKSClusterUpgrade: %w: version %q is not available", ErrInvalidRequest, version)
+	}
+	if err := checkSKSVersionUpgrade(cluster.Version, version); err != nil {
+		return nil, fmt.Errorf("PlanSKSClusterUpgrade: %w", err)
+	}
+
+	deprecated, err := c.ListSKSClusterDeprecatedResources(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("PlanSKSClusterUpgrade: %w", err)
+	}
+	if len(deprecated) > 0 && !o.allowDeprecatedResources {
+		return nil, fmt.Errorf("PlanSKSClusterUpgrade: %w: %d resources use deprecated APIs", ErrDeprecatedResources, len(deprecated))
+	}
+
+	plan := &SKSClusterUpgradePlan{
+		ClusterID:           id,
+		FromVersion:         cluster.Version,
+		ToVersion:           version,
+		DeprecatedResources: deprecated,
+	}
+
+	for _, np := range cluster.Nodepools {
+		nodepool := SKSNodepoolUpgradePlan{ID: np.ID, Name: np.Name, Size: np.Size}
+		if np.InstancePool != nil {
+			pool, err := c.GetInstancePool(ctx, np.InstancePool.ID)
+			if err != nil {
+				return nil, fmt.Errorf("PlanSKSClusterUpgrade: nodepool %q: %w", np.Name, err)
+			}
+			for _, instance := range pool.Instances {
+				nodepool.Nodes = append(nodepool.Nodes, instance.ID)
+			}
+		}
+		plan.Nodepools = append(plan.Nodepools, nodepool)
+	}
+
+	return plan, nil
+}
+
+// checkSKSVersionUpgrade checks that an SKS cluster can be upgraded from a
+// Kubernetes version to another: Kubernetes doesn't support skipping minor versions.
+func checkSKSVersionUpgrade(from, to string) error {
+	current, err := parseSKSVersion(from)
+	if err != nil {
+		return err
+	}
+	target, err := parseSKSVersion(to)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case slices.Compare(target, current) <= 0:
+		return fmt.Errorf("%w: version %q is not newer than %q", ErrInvalidRequest, to, from)
+	case target[0] != current[0] || target[1] > current[1]+1:
+		return fmt.Errorf("%w: cannot upgrade from %q to %q, minor versions cannot be skipped", ErrInvalidRequest, from, to)
+	}
+
+	return nil
+}
+
+// parseSKSVersion parses a Kubernetes version such as "1.30.6" into its numeric parts.
+func parseSKSVersion(version string) ([]int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: invalid version %q", ErrInvalidRequest, version)
+	}
+
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid version %q", ErrInvalidRequest, version)
+		}
+		numbers[i] = n
+	}
+
+	return numbers, nil
+}
+
+// ExecuteSKSClusterUpgradeOpt represents a function setting an ExecuteSKSClusterUpgrade option.
+type ExecuteSKSClusterUpgradeOpt func(*executeSKSClusterUpgradeOptions)
+
+type executeSKSClusterUpgradeOptions struct {
+	surge     int
+	nodeReady func(context.Context, *Instance) error
+	progress  func(*SKSClusterUpgradePlan)
+}
+
+// ExecuteSKSClusterUpgradeOptWithSurge returns an ExecuteSKSClusterUpgradeOpt
+// overriding the number of nodes replaced at once in a Nodepool (default: 1).
+func ExecuteSKSClusterUpgradeOptWithSurge(n int) ExecuteSKSClusterUpgradeOpt {
+	return func(o *executeSKSClusterUpgradeOptions) {
+		o.surge = n
+	}
+}
+
+// ExecuteSKSClusterUpgradeOptWithNodeReady returns an ExecuteSKSClusterUpgradeOpt
+// setting a function called once a new node instance is running, which must
+// return when the node is ready to take over the workloads of the old ones,
+// e.g. when the Kubernetes node is Ready.
+func ExecuteSKSClusterUpgradeOptWithNodeReady(fn func(ctx context.Context, instance *Instance) error) ExecuteSKSClusterUpgradeOpt {
+	return func(o *executeSKSClusterUpgradeOptions) {
+		o.nodeReady = fn
+	}
+}
+
+// ExecuteSKSClusterUpgradeOptWithProgress returns an ExecuteSKSClusterUpgradeOpt
+// setting a callback invoked with the updated plan after each step, e.g. to
+// persist it.
+func ExecuteSKSClusterUpgradeOptWithProgress(fn func(*SKSClusterUpgradePlan)) ExecuteSKSClusterUpgradeOpt {
+	return func(o *executeSKSClusterUpgradeOptions) {
+		o.progress = fn
+	}
+}
+
+// ExecuteSKSClusterUpgrade executes an SKS cluster upgrade plan: the control
+// plane is upgraded, then the nodes of each Nodepool are replaced by batches:
+// the Nodepool is scaled up by the batch size, the new nodes are waited for,
+// and the old nodes are evicted, which scales the Nodepool back down.
+//
+// The plan is updated after each step. Upon failure, executing the same plan
+// again resumes the upgrade where it stopped.
+func (c Client) ExecuteSKSClusterUpgrade(ctx context.Context, plan *SKSClusterUpgradePlan, opts ...ExecuteSKSClusterUpgradeOpt) error {
+	o := &executeSKSClusterUpgradeOptions{surge: 1}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.surge < 1 {
+		return fmt.Errorf("ExecuteSKSClusterUpgrade: %w: surge must be positive", ErrInvalidRequest)
+	}
+
+	progress := func() {
+		if o.progress != nil {
+			o.progress(plan)
+		}
+	}
+
+	if !plan.ControlPlaneUpgraded {
+		if err := c.upgradeSKSControlPlane(ctx, plan.ClusterID, plan.ToVersion); err != nil {
+			return fmt.Errorf("ExecuteSKSClusterUpgrade: %w", err)
+		}
+		plan.ControlPlaneUpgraded = true
+		progress()
+	}
+
+	for i := range plan.Nodepools {
+		if err := c.replaceSKSNodepoolNodes(ctx, plan.ClusterID, &plan.Nodepools[i], o, progress); err != nil {
+			return fmt.Errorf("ExecuteSKSClusterUpgrade: nodepool %q: %w", plan.Nodepools[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+// upgradeSKSControlPlane upgrades the control plane of an SKS cluster unless it
+// already runs the version, waiting for a previously started upgrade if any.
+func (c Client) upgradeSKSControlPlane(ctx context.Context, id UUID, version string) error {
+	cluster, err := c.GetSKSCluster(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if cluster.State == SKSClusterStateUpgrading {
+		if cluster, err = c.WaitSKSClusterState(ctx, id, SKSClusterStateRunning); err != nil {
+			return err
+		}
+	}
+	if cluster.Version == version {
+		return nil
+	}
+
+	op, err := c.UpgradeSKSCluster(ctx, id, UpgradeSKSClusterRequest{Version: version})
+	if err != nil {
+		return err
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// replaceSKSNodepoolNodes replaces the remaining nodes of a Nodepool upgrade plan.
+func (c Client) replaceSKSNodepoolNodes(ctx context.Context, clusterID UUID, plan *SKSNodepoolUpgradePlan, o *executeSKSClusterUpgradeOptions, progress func()) error {
+	// ready holds the replacement nodes found ready, so that each batch only
+	// waits for its own replacement nodes.
+	ready := make(map[UUID]bool)
+
+	for {
+		nodepool, err := c.GetSKSNodepool(ctx, clusterID, plan.ID)
+		if err != nil {
+			return err
+		}
+		if nodepool.InstancePool == nil {
+			return fmt.Errorf("nodepool has no instance pool")
+		}
+		pool, err := c.GetInstancePool(ctx, nodepool.InstancePool.ID)
+		if err != nil {
+			return err
+		}
+
+		// Nodes no longer members of the Nodepool have been replaced, e.g. by a
+		// previous execution.
+		remaining := slices.DeleteFunc(slices.Clone(plan.Nodes), func(id UUID) bool {
+			return !slices.ContainsFunc(pool.Instances, func(i Instance) bool { return i.ID == id })
+		})
+		if len(remaining) != len(plan.Nodes) {
+			plan.Nodes = remaining
+			progress()
+		}
+
+		if len(plan.Nodes) == 0 {
+			if nodepool.Size > plan.Size {
+				return c.scaleSKSNodepool(ctx, clusterID, plan.ID, plan.Size)
+			}
+			return nil
+		}
+
+		batch := plan.Nodes[:min(o.surge, len(plan.Nodes))]
+		if size := plan.Size + int64(len(batch)); nodepool.Size < size {
+			if err := c.scaleSKSNodepool(ctx, clusterID, plan.ID, size); err != nil {
+				return err
+			}
+			if pool, err = c.GetInstancePool(ctx, pool.ID); err != nil {
+				return err
+			}
+		}
+
+		for _, instance := range pool.Instances {
+			if slices.Contains(plan.Nodes, instance.ID) || ready[instance.ID] {
+				continue
+			}
+			if err := c.waitSKSNodeReady(ctx, instance.ID, o); err != nil {
+				return err
+			}
+			ready[instance.ID] = true
+		}
+
+		op, err := c.EvictSKSNodepoolMembers(ctx, clusterID, plan.ID, EvictSKSNodepoolMembersRequest{
+			Instances: batch,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+			return err
+		}
+
+		plan.Nodes = plan.Nodes[len(batch):]
+		progress()
+	}
+}
+
+func (c Client) scaleSKSNodepool(ctx context.Context, clusterID, nodepoolID UUID, size int64) error {
+	op, err := c.ScaleSKSNodepool(ctx, clusterID, nodepoolID, ScaleSKSNodepoolRequest{Size: size})
+	if err != nil {
+		return err
+	}
+	if _, err := c.Wait(ctx, op, OperationStateSuccess); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitSKSNodeReady waits for a node instance to be running, then ready
+// according to the configured function if any.
+func (c Client) waitSKSNodeReady(ctx context.Context, id UUID, o *executeSKSClusterUpgradeOptions) error {
+	instance, err := c.WaitInstanceState(ctx, id, InstanceStateRunning)
+	if err != nil {
+		return fmt.Errorf("node %s: %w", id, err)
+	}
+
+	if o.nodeReady != nil {
+		if err := o.nodeReady(ctx, instance); err != nil {
+			return fmt.Errorf("node %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
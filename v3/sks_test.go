@@ -0,0 +1,110 @@
+package v3_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+func TestSKSClusterUpgrade(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	op, err := client.CreateSKSCluster(ctx, v3.CreateSKSClusterRequest{Name: "prod", Version: "1.30.6"})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	clusterID := op.Reference.ID
+
+	op, err = client.CreateSKSNodepool(ctx, clusterID, v3.CreateSKSNodepoolRequest{Name: "workers", Size: 3})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	nodepoolID := op.Reference.ID
+
+	t.Run("Validation", func(t *testing.T) {
+		for _, version := range []string{"1.32.0", "1.30.6", "1.29.10"} {
+			_, err := client.PlanSKSClusterUpgrade(ctx, clusterID, version)
+			require.ErrorIs(t, err, v3.ErrInvalidRequest, version)
+		}
+
+		s.SetSKSClusterDeprecatedResources(clusterID, []v3.SKSClusterDeprecatedResource{
+			{"group": "policy", "version": "v1beta1", "resource": "podsecuritypolicies"},
+		})
+		defer s.SetSKSClusterDeprecatedResources(clusterID, nil)
+
+		_, err := client.PlanSKSClusterUpgrade(ctx, clusterID, "1.31.2")
+		require.ErrorIs(t, err, v3.ErrDeprecatedResources)
+
+		plan, err := client.PlanSKSClusterUpgrade(ctx, clusterID, "1.31.2", v3.PlanSKSClusterUpgradeOptWithDeprecatedResources())
+		require.NoError(t, err)
+		require.Len(t, plan.DeprecatedResources, 1)
+	})
+
+	plan, err := client.PlanSKSClusterUpgrade(ctx, clusterID, "1.31.2")
+	require.NoError(t, err)
+	require.Equal(t, "1.30.6", plan.FromVersion)
+	require.Len(t, plan.Nodepools, 1)
+	require.Len(t, plan.Nodepools[0].Nodes, 3)
+	oldNodes := plan.Nodepools[0].Nodes
+
+	// The upgrade is interrupted while replacing the first nodes.
+	s.InjectFault(testserver.Fault{
+		Method:     http.MethodPut,
+		Path:       "/sks-cluster/" + clusterID.String() + "/nodepool/" + nodepoolID.String() + ":evict",
+		StatusCode: http.StatusInternalServerError,
+		Message:    "boom",
+		Times:      1,
+	})
+
+	var steps int
+	err = client.ExecuteSKSClusterUpgrade(ctx, plan,
+		v3.ExecuteSKSClusterUpgradeOptWithSurge(2),
+		v3.ExecuteSKSClusterUpgradeOptWithProgress(func(*v3.SKSClusterUpgradePlan) { steps++ }),
+	)
+	require.Error(t, err)
+	require.True(t, plan.ControlPlaneUpgraded)
+	require.False(t, plan.Done())
+	require.Equal(t, 1, steps)
+
+	cluster, err := client.GetSKSCluster(ctx, clusterID)
+	require.NoError(t, err)
+	require.Equal(t, "1.31.2", cluster.Version)
+
+	var ready []v3.UUID
+	err = client.ExecuteSKSClusterUpgrade(ctx, plan,
+		v3.ExecuteSKSClusterUpgradeOptWithSurge(2),
+		v3.ExecuteSKSClusterUpgradeOptWithNodeReady(func(_ context.Context, instance *v3.Instance) error {
+			require.Equal(t, v3.InstanceStateRunning, instance.State)
+			ready = append(ready, instance.ID)
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+	require.True(t, plan.Done())
+
+	nodepool, err := client.GetSKSNodepool(ctx, clusterID, nodepoolID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), nodepool.Size)
+
+	pool, err := client.GetInstancePool(ctx, nodepool.InstancePool.ID)
+	require.NoError(t, err)
+	require.Len(t, pool.Instances, 3)
+	var nodes []v3.UUID
+	for _, instance := range pool.Instances {
+		require.NotContains(t, oldNodes, instance.ID)
+		nodes = append(nodes, instance.ID)
+	}
+	// Each replacement node is checked once, by the batch it replaces nodes of.
+	require.ElementsMatch(t, nodes, ready)
+}
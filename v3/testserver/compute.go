@@ -0,0 +1,902 @@
+package testserver
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+func (s *Server) registerCompute(mux *http.ServeMux) {
+	mux.HandleFunc("GET /instance", s.listInstances)
+	mux.HandleFunc("POST /instance", s.createInstance)
+	mux.HandleFunc("GET /instance/{id}", s.getInstance)
+	mux.HandleFunc("PUT /instance/{id}", s.updateInstance)
+	mux.HandleFunc("DELETE /instance/{id}", s.deleteInstance)
+
+	mux.HandleFunc("GET /instance-pool", s.listInstancePools)
+	mux.HandleFunc("POST /instance-pool", s.createInstancePool)
+	mux.HandleFunc("GET /instance-pool/{id}", s.getInstancePool)
+	mux.HandleFunc("PUT /instance-pool/{id}", s.updateInstancePool)
+	mux.HandleFunc("DELETE /instance-pool/{id}", s.deleteInstancePool)
+
+	mux.HandleFunc("GET /security-group", s.listSecurityGroups)
+	mux.HandleFunc("POST /security-group", s.createSecurityGroup)
+	mux.HandleFunc("GET /security-group/{id}", s.getSecurityGroup)
+	mux.HandleFunc("PUT /security-group/{id}", s.updateSecurityGroupMembers)
+	mux.HandleFunc("DELETE /security-group/{id}", s.deleteSecurityGroup)
+	mux.HandleFunc("POST /security-group/{id}/rules", s.addSecurityGroupRule)
+	mux.HandleFunc("DELETE /security-group/{id}/rules/{rule}", s.deleteSecurityGroupRule)
+
+	mux.HandleFunc("GET /private-network", s.listPrivateNetworks)
+	mux.HandleFunc("POST /private-network", s.createPrivateNetwork)
+	mux.HandleFunc("GET /private-network/{id}", s.getPrivateNetwork)
+	mux.HandleFunc("PUT /private-network/{id}", s.updatePrivateNetwork)
+	mux.HandleFunc("DELETE /private-network/{id}", s.deletePrivateNetwork)
+	mux.HandleFunc("DELETE /private-network/{id}/{field}", s.resetPrivateNetworkField)
+
+	mux.HandleFunc("GET /ssh-key", s.listSSHKeys)
+	mux.HandleFunc("POST /ssh-key", s.registerSSHKey)
+	mux.HandleFunc("GET /ssh-key/{name}", s.getSSHKey)
+	mux.HandleFunc("DELETE /ssh-key/{name}", s.deleteSSHKey)
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instances := []*v3.Instance{}
+	for _, i := range s.instances {
+		instances = append(instances, i)
+	}
+
+	writeJSON(w, map[string]any{"instances": instances})
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateInstanceRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sg := range req.SecurityGroups {
+		if _, ok := s.securityGroups[sg.ID]; !ok {
+			writeError(w, http.StatusNotFound, "security group not found")
+			return
+		}
+	}
+
+	instance := &v3.Instance{
+		ID:                 newUUID(),
+		Name:               req.Name,
+		CreatedAT:          time.Now().UTC(),
+		DiskSize:           req.DiskSize,
+		InstanceType:       req.InstanceType,
+		Template:           req.Template,
+		Labels:             req.Labels,
+		SecurityGroups:     req.SecurityGroups,
+		AntiAffinityGroups: req.AntiAffinityGroups,
+		SSHKey:             req.SSHKey,
+		SSHKeys:            req.SSHKeys,
+		UserData:           req.UserData,
+		PublicIPAssignment: req.PublicIPAssignment,
+		PublicIP:           s.nextPublicIP(),
+		State:              v3.InstanceStateStarting,
+	}
+	s.instances[instance.ID] = instance
+
+	target := v3.InstanceStateRunning
+	if req.AutoStart != nil && !*req.AutoStart {
+		target = v3.InstanceStateStopped
+	}
+
+	s.newOperation(w, "create-instance", "/instance/"+instance.ID.String(), instance.ID, func() {
+		instance.State = target
+	})
+}
+
+// SetInstanceState sets the state of a Compute instance, e.g. to simulate a
+// failure. It panics if the instance doesn't exist.
+func (s *Server) SetInstanceState(id v3.UUID, state v3.InstanceState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instance, ok := s.instances[id]
+	if !ok {
+		panic(fmt.Sprintf("testserver: instance %s not found", id))
+	}
+
+	instance.State = state
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instance, ok := s.instances[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+
+	writeJSON(w, instance)
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+
+	var req v3.UpdateInstanceRequest
+	if action == "" && !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instance, ok := s.instances[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+
+	var done func()
+	switch action {
+	case "":
+		if req.Name != "" {
+			instance.Name = req.Name
+		}
+		if req.Labels != nil {
+			instance.Labels = req.Labels
+		}
+		if req.UserData != "" {
+			instance.UserData = req.UserData
+		}
+	case "start":
+		instance.State = v3.InstanceStateStarting
+		done = func() { instance.State = v3.InstanceStateRunning }
+	case "stop":
+		instance.State = v3.InstanceStateStopping
+		done = func() { instance.State = v3.InstanceStateStopped }
+	default:
+		writeError(w, http.StatusNotFound, "unsupported action: "+action)
+		return
+	}
+
+	s.newOperation(w, "update-instance", "/instance/"+id.String(), id, done)
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.instances[id]; !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+	delete(s.instances, id)
+
+	for _, pn := range s.privateNetworks {
+		pn.Leases = slices.DeleteFunc(pn.Leases, func(l v3.PrivateNetworkLease) bool {
+			return l.InstanceID == id
+		})
+	}
+
+	s.newOperation(w, "delete-instance", "/instance/"+id.String(), id, nil)
+}
+
+func (s *Server) listInstancePools(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pools := []v3.InstancePool{}
+	for _, p := range s.instancePools {
+		pools = append(pools, *p)
+	}
+
+	writeJSON(w, v3.ListInstancePoolsResponse{InstancePools: pools})
+}
+
+func (s *Server) createInstancePool(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateInstancePoolRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.Size <= 0 {
+		writeError(w, http.StatusBadRequest, "missing name or size")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := req.InstancePrefix
+	if prefix == "" {
+		prefix = "pool"
+	}
+	pool := &v3.InstancePool{
+		ID:                 newUUID(),
+		Name:               req.Name,
+		Description:        req.Description,
+		AntiAffinityGroups: req.AntiAffinityGroups,
+		DiskSize:           req.DiskSize,
+		InstancePrefix:     prefix,
+		InstanceType:       req.InstanceType,
+		Labels:             req.Labels,
+		SecurityGroups:     req.SecurityGroups,
+		Template:           req.Template,
+		UserData:           req.UserData,
+		State:              v3.InstancePoolStateCreating,
+	}
+	pool.Manager = &v3.Manager{ID: pool.ID, Type: v3.ManagerTypeInstancePool}
+	s.instancePools[pool.ID] = pool
+	startInstances := s.resizeInstancePool(pool, req.Size)
+
+	s.newOperation(w, "create-instance-pool", "/instance-pool/"+pool.ID.String(), pool.ID, func() {
+		startInstances()
+		pool.State = v3.InstancePoolStateRunning
+	})
+}
+
+func (s *Server) getInstancePool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.instancePools[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance pool not found")
+		return
+	}
+
+	writeJSON(w, pool)
+}
+
+func (s *Server) updateInstancePool(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+
+	var (
+		req   v3.UpdateInstancePoolRequest
+		scale v3.ScaleInstancePoolRequest
+		evict v3.EvictInstancePoolMembersRequest
+	)
+	switch action {
+	case "":
+		if !readJSON(w, r, &req) {
+			return
+		}
+	case "scale":
+		if !readJSON(w, r, &scale) {
+			return
+		}
+	case "evict":
+		if !readJSON(w, r, &evict) {
+			return
+		}
+	default:
+		writeError(w, http.StatusNotFound, "unsupported action: "+action)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pool, ok := s.instancePools[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance pool not found")
+		return
+	}
+
+	var done func()
+	switch action {
+	case "":
+		if req.Name != "" {
+			pool.Name = req.Name
+		}
+		if req.Description != "" {
+			pool.Description = req.Description
+		}
+		if req.DiskSize != 0 {
+			pool.DiskSize = req.DiskSize
+		}
+		if req.InstanceType != nil {
+			pool.InstanceType = req.InstanceType
+		}
+		if req.Template != nil {
+			pool.Template = req.Template
+		}
+		if req.Labels != nil {
+			pool.Labels = req.Labels
+		}
+		if req.UserData != nil {
+			pool.UserData = *req.UserData
+		}
+	case "scale":
+		if scale.Size <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid size")
+			return
+		}
+		pool.State = v3.InstancePoolStateScalingUP
+		if scale.Size < pool.Size {
+			pool.State = v3.InstancePoolStateScalingDown
+		}
+		startInstances := s.resizeInstancePool(pool, scale.Size)
+		done = func() {
+			startInstances()
+			pool.State = v3.InstancePoolStateRunning
+		}
+	case "evict":
+		if err := s.evictInstancePoolMembers(pool, evict.Instances); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	s.newOperation(w, "update-instance-pool", "/instance-pool/"+id.String(), id, done)
+}
+
+func (s *Server) deleteInstancePool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	pool, ok := s.instancePools[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance pool not found")
+		return
+	}
+	if pool.Manager != nil && pool.Manager.Type != v3.ManagerTypeInstancePool {
+		writeError(w, http.StatusForbidden, "instance pool is managed by "+string(pool.Manager.Type))
+		return
+	}
+	s.resizeInstancePool(pool, 0)
+	delete(s.instancePools, id)
+
+	s.newOperation(w, "delete-instance-pool", "/instance-pool/"+id.String(), id, nil)
+}
+
+// resizeInstancePool creates or removes the members of an Instance Pool to match
+// the given size, and returns a function starting the created members.
+// It must be called with the Server lock held.
+func (s *Server) resizeInstancePool(pool *v3.InstancePool, size int64) func() {
+	var created []*v3.Instance
+	for int64(len(pool.Instances)) < size {
+		id := newUUID()
+		instance := &v3.Instance{
+			ID:           id,
+			Name:         pool.InstancePrefix + "-" + id.String()[:8],
+			CreatedAT:    time.Now().UTC(),
+			DiskSize:     pool.DiskSize,
+			InstanceType: pool.InstanceType,
+			Template:     pool.Template,
+			Labels:       pool.Labels,
+			UserData:     pool.UserData,
+			Manager:      pool.Manager,
+			PublicIP:     s.nextPublicIP(),
+			State:        v3.InstanceStateStarting,
+		}
+		s.instances[id] = instance
+		pool.Instances = append(pool.Instances, v3.Instance{ID: id})
+		created = append(created, instance)
+	}
+	for int64(len(pool.Instances)) > size {
+		delete(s.instances, pool.Instances[len(pool.Instances)-1].ID)
+		pool.Instances = pool.Instances[:len(pool.Instances)-1]
+	}
+	pool.Size = size
+
+	return func() {
+		for _, instance := range created {
+			instance.State = v3.InstanceStateRunning
+		}
+	}
+}
+
+// evictInstancePoolMembers removes members of an Instance Pool, shrinking it.
+// It must be called with the Server lock held.
+func (s *Server) evictInstancePoolMembers(pool *v3.InstancePool, ids []v3.UUID) error {
+	for _, id := range ids {
+		if !slices.ContainsFunc(pool.Instances, func(i v3.Instance) bool { return i.ID == id }) {
+			return fmt.Errorf("instance %s is not a member of the instance pool", id)
+		}
+	}
+
+	for _, id := range ids {
+		delete(s.instances, id)
+		pool.Instances = slices.DeleteFunc(pool.Instances, func(i v3.Instance) bool { return i.ID == id })
+	}
+	pool.Size = int64(len(pool.Instances))
+
+	return nil
+}
+
+func (s *Server) listSecurityGroups(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := []v3.SecurityGroup{}
+	for _, sg := range s.securityGroups {
+		groups = append(groups, *sg)
+	}
+
+	writeJSON(w, v3.ListSecurityGroupsResponse{SecurityGroups: groups})
+}
+
+func (s *Server) createSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateSecurityGroupRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sg := range s.securityGroups {
+		if sg.Name == req.Name {
+			writeError(w, http.StatusConflict, "a security group with this name already exists")
+			return
+		}
+	}
+
+	sg := &v3.SecurityGroup{
+		ID:          newUUID(),
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	s.securityGroups[sg.ID] = sg
+
+	s.newOperation(w, "create-security-group", "/security-group/"+sg.ID.String(), sg.ID, nil)
+}
+
+func (s *Server) getSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.securityGroups[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "security group not found")
+		return
+	}
+
+	writeJSON(w, sg)
+}
+
+// updateSecurityGroupMembers handles the Security Group attach/detach and
+// add-source/remove-source actions.
+func (s *Server) updateSecurityGroupMembers(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+	if action == "add-source" || action == "remove-source" {
+		s.updateSecurityGroupSources(w, r, id, action)
+		return
+	}
+
+	var req v3.AttachInstanceToSecurityGroupRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.securityGroups[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "security group not found")
+		return
+	}
+	if req.Instance == nil {
+		writeError(w, http.StatusBadRequest, "missing instance")
+		return
+	}
+	instance, ok := s.instances[req.Instance.ID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance not found")
+		return
+	}
+
+	isMember := func(m v3.SecurityGroup) bool { return m.ID == id }
+	switch action {
+	case "attach":
+		if !slices.ContainsFunc(instance.SecurityGroups, isMember) {
+			instance.SecurityGroups = append(instance.SecurityGroups, v3.SecurityGroup{ID: sg.ID, Name: sg.Name})
+		}
+	case "detach":
+		instance.SecurityGroups = slices.DeleteFunc(instance.SecurityGroups, isMember)
+	default:
+		writeError(w, http.StatusNotFound, "unsupported action: "+action)
+		return
+	}
+
+	s.newOperation(w, action+"-instance-to-security-group", "/security-group/"+id.String(), id, nil)
+}
+
+func (s *Server) updateSecurityGroupSources(w http.ResponseWriter, r *http.Request, id v3.UUID, action string) {
+	var req v3.AddExternalSourceToSecurityGroupRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.securityGroups[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "security group not found")
+		return
+	}
+
+	if action == "add-source" {
+		if slices.Contains(sg.ExternalSources, req.Cidr) {
+			writeError(w, http.StatusConflict, "external source already exists")
+			return
+		}
+		sg.ExternalSources = append(sg.ExternalSources, req.Cidr)
+	} else {
+		sg.ExternalSources = slices.DeleteFunc(sg.ExternalSources, func(cidr string) bool { return cidr == req.Cidr })
+	}
+
+	s.newOperation(w, action+"-external-source-security-group", "/security-group/"+id.String(), id, nil)
+}
+
+func (s *Server) deleteSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.securityGroups[id]; !ok {
+		writeError(w, http.StatusNotFound, "security group not found")
+		return
+	}
+	for _, i := range s.instances {
+		if slices.ContainsFunc(i.SecurityGroups, func(m v3.SecurityGroup) bool { return m.ID == id }) {
+			writeError(w, http.StatusConflict, "security group is in use")
+			return
+		}
+	}
+	delete(s.securityGroups, id)
+
+	s.newOperation(w, "delete-security-group", "/security-group/"+id.String(), id, nil)
+}
+
+func (s *Server) addSecurityGroupRule(w http.ResponseWriter, r *http.Request) {
+	var req v3.AddRuleToSecurityGroupRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	sg, ok := s.securityGroups[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "security group not found")
+		return
+	}
+	if req.Network == "" && req.SecurityGroup == nil {
+		writeError(w, http.StatusBadRequest, "either network or security-group must be set")
+		return
+	}
+	if (req.Protocol == "tcp" || req.Protocol == "udp") && (req.StartPort == 0 || req.EndPort == 0) {
+		writeError(w, http.StatusBadRequest, "start-port and end-port must be set")
+		return
+	}
+	if ref := req.SecurityGroup; ref != nil {
+		found := false
+		for _, other := range s.securityGroups {
+			if other.ID == ref.ID || (ref.ID == "" && other.Name == ref.Name) {
+				req.SecurityGroup = &v3.SecurityGroupResource{ID: other.ID, Name: other.Name}
+				found = true
+				break
+			}
+		}
+		if !found {
+			writeError(w, http.StatusNotFound, "referenced security group not found")
+			return
+		}
+	}
+
+	rule := v3.SecurityGroupRule{
+		ID:            newUUID(),
+		Description:   req.Description,
+		FlowDirection: v3.SecurityGroupRuleFlowDirection(req.FlowDirection),
+		Protocol:      v3.SecurityGroupRuleProtocol(req.Protocol),
+		Network:       req.Network,
+		SecurityGroup: req.SecurityGroup,
+		StartPort:     req.StartPort,
+		EndPort:       req.EndPort,
+	}
+	if req.ICMP != nil {
+		rule.ICMP = &v3.SecurityGroupRuleICMP{Code: req.ICMP.Code, Type: req.ICMP.Type}
+	}
+	sg.Rules = append(sg.Rules, rule)
+
+	s.newOperation(w, "add-rule-to-security-group", "/security-group/"+id.String(), id, nil)
+}
+
+func (s *Server) deleteSecurityGroupRule(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	sg, ok := s.securityGroups[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "security group not found")
+		return
+	}
+
+	ruleID := v3.UUID(r.PathValue("rule"))
+	n := len(sg.Rules)
+	sg.Rules = slices.DeleteFunc(sg.Rules, func(rule v3.SecurityGroupRule) bool { return rule.ID == ruleID })
+	if len(sg.Rules) == n {
+		writeError(w, http.StatusNotFound, "security group rule not found")
+		return
+	}
+
+	s.newOperation(w, "delete-rule-from-security-group", "/security-group/"+id.String(), id, nil)
+}
+
+func (s *Server) listPrivateNetworks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	networks := []v3.PrivateNetwork{}
+	for _, pn := range s.privateNetworks {
+		networks = append(networks, *pn)
+	}
+
+	writeJSON(w, v3.ListPrivateNetworksResponse{PrivateNetworks: networks})
+}
+
+func (s *Server) createPrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreatePrivateNetworkRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pn := &v3.PrivateNetwork{
+		ID:          newUUID(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		StartIP:     req.StartIP,
+		EndIP:       req.EndIP,
+		Netmask:     req.Netmask,
+		Vni:         int64(len(s.privateNetworks) + 1),
+	}
+	s.privateNetworks[pn.ID] = pn
+
+	s.newOperation(w, "create-private-network", "/private-network/"+pn.ID.String(), pn.ID, nil)
+}
+
+func (s *Server) getPrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pn, ok := s.privateNetworks[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "private network not found")
+		return
+	}
+
+	writeJSON(w, pn)
+}
+
+// updatePrivateNetwork handles the Private Network update and attach/detach actions.
+func (s *Server) updatePrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+
+	var (
+		update v3.UpdatePrivateNetworkRequest
+		attach v3.AttachInstanceToPrivateNetworkRequest
+		detach v3.DetachInstanceFromPrivateNetworkRequest
+		ok     bool
+	)
+	switch action {
+	case "":
+		ok = readJSON(w, r, &update)
+	case "attach":
+		ok = readJSON(w, r, &attach)
+	case "detach":
+		ok = readJSON(w, r, &detach)
+	default:
+		writeError(w, http.StatusNotFound, "unsupported action: "+action)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pn, ok := s.privateNetworks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "private network not found")
+		return
+	}
+
+	switch action {
+	case "":
+		if update.Name != "" {
+			pn.Name = update.Name
+		}
+		if update.Description != "" {
+			pn.Description = update.Description
+		}
+		if update.Labels != nil {
+			pn.Labels = update.Labels
+		}
+		if update.StartIP != nil {
+			pn.StartIP = update.StartIP
+		}
+		if update.EndIP != nil {
+			pn.EndIP = update.EndIP
+		}
+		if update.Netmask != nil {
+			pn.Netmask = update.Netmask
+		}
+
+	case "attach":
+		if attach.Instance == nil {
+			writeError(w, http.StatusBadRequest, "missing instance")
+			return
+		}
+		instance, found := s.instances[attach.Instance.ID]
+		if !found {
+			writeError(w, http.StatusNotFound, "instance not found")
+			return
+		}
+		pn.Leases = append(pn.Leases, v3.PrivateNetworkLease{InstanceID: instance.ID, IP: attach.IP})
+		instance.PrivateNetworks = append(instance.PrivateNetworks, v3.InstancePrivateNetworks{ID: pn.ID})
+
+	case "detach":
+		if detach.Instance == nil {
+			writeError(w, http.StatusBadRequest, "missing instance")
+			return
+		}
+		instanceID := detach.Instance.ID
+		pn.Leases = slices.DeleteFunc(pn.Leases, func(l v3.PrivateNetworkLease) bool { return l.InstanceID == instanceID })
+		if instance, found := s.instances[instanceID]; found {
+			instance.PrivateNetworks = slices.DeleteFunc(instance.PrivateNetworks, func(p v3.InstancePrivateNetworks) bool {
+				return p.ID == pn.ID
+			})
+		}
+	}
+
+	s.newOperation(w, "update-private-network", "/private-network/"+id.String(), id, nil)
+}
+
+func (s *Server) deletePrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	pn, ok := s.privateNetworks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "private network not found")
+		return
+	}
+	if len(pn.Leases) > 0 {
+		writeError(w, http.StatusConflict, "private network is in use")
+		return
+	}
+	delete(s.privateNetworks, id)
+
+	s.newOperation(w, "delete-private-network", "/private-network/"+id.String(), id, nil)
+}
+
+func (s *Server) resetPrivateNetworkField(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	pn, ok := s.privateNetworks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "private network not found")
+		return
+	}
+
+	switch r.PathValue("field") {
+	case string(v3.ResetPrivateNetworkFieldFieldLabels):
+		pn.Labels = nil
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported field: "+r.PathValue("field"))
+		return
+	}
+
+	s.newOperation(w, "reset-private-network-field", "/private-network/"+id.String(), id, nil)
+}
+
+func (s *Server) listSSHKeys(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := []v3.SSHKey{}
+	for _, key := range s.sshKeys {
+		keys = append(keys, *key)
+	}
+
+	writeJSON(w, v3.ListSSHKeysResponse{SSHKeys: keys})
+}
+
+func (s *Server) registerSSHKey(w http.ResponseWriter, r *http.Request) {
+	var req v3.RegisterSSHKeyRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	fingerprint, err := sshKeyFingerprint(req.PublicKey)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid public key")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sshKeys[req.Name]; ok {
+		writeError(w, http.StatusConflict, "SSH key already exists")
+		return
+	}
+	s.sshKeys[req.Name] = &v3.SSHKey{Name: req.Name, Fingerprint: fingerprint}
+
+	s.newOperation(w, "register-ssh-key", "/ssh-key/"+req.Name, "", nil)
+}
+
+func (s *Server) getSSHKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.sshKeys[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SSH key not found")
+		return
+	}
+
+	writeJSON(w, key)
+}
+
+func (s *Server) deleteSSHKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := r.PathValue("name")
+	if _, ok := s.sshKeys[name]; !ok {
+		writeError(w, http.StatusNotFound, "SSH key not found")
+		return
+	}
+	delete(s.sshKeys, name)
+
+	s.newOperation(w, "delete-ssh-key", "/ssh-key/"+name, "", nil)
+}
+
+// sshKeyFingerprint returns the MD5 fingerprint of an authorized_keys formatted
+// public key, as reported by the API.
+func sshKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("invalid public key")
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", err
+	}
+
+	sum := md5.Sum(blob) //nolint:gosec
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02x", b)
+	}
+
+	return strings.Join(hex, ":"), nil
+}
@@ -0,0 +1,317 @@
+package testserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// dbaasService is a DBaaS service of any type, whose user secrets are only
+// returned by the reveal endpoints.
+type dbaasService struct {
+	// kind is the kind of the service in the API paths, e.g. "postgres".
+	kind    string
+	common  v3.DBAASServiceCommon
+	service any
+	secrets map[string]any
+	// logs are in chronological order, their offset being their index.
+	logs []v3.DBAASServiceLogsLogs
+}
+
+func (s *Server) registerDBAAS(mux *http.ServeMux) {
+	mux.HandleFunc("GET /dbaas-service", s.listDBAASServices)
+	mux.HandleFunc("GET /dbaas-ca-certificate", s.getDBAASCACertificate)
+	mux.HandleFunc("POST /dbaas-service-logs/{name}", s.getDBAASServiceLogs)
+	for _, kind := range []string{"postgres", "mysql", "redis", "kafka", "opensearch"} {
+		mux.HandleFunc("GET /dbaas-"+kind+"/{name}", s.getDBAASService(kind))
+		mux.HandleFunc("GET /dbaas-"+kind+"/{name}/user/{username}/password/reveal", s.revealDBAASUserPassword(kind))
+	}
+}
+
+// AddDBAASService adds a running DBaaS service to the Server, one of
+// *v3.DBAASServicePG, *v3.DBAASServiceMysql, *v3.DBAASServiceRedis,
+// *v3.DBAASServiceKafka or *v3.DBAASServiceOpensearch. The passwords of its
+// users, and the access keys of Kafka users, are only returned by the
+// Reveal*UserPassword operations. The service is running unless it has
+// another state. It panics on other types.
+func (s *Server) AddDBAASService(service any) {
+	svc := &dbaasService{secrets: make(map[string]any)}
+
+	switch v := service.(type) {
+	case *v3.DBAASServicePG:
+		c := *v
+		c.Type = "pg"
+		c.Users = slices.Clone(c.Users)
+		for i, u := range c.Users {
+			svc.secrets[u.Username] = v3.DBAASUserPostgresSecrets{Username: u.Username, Password: u.Password}
+			c.Users[i].Password = ""
+		}
+		svc.kind, svc.service = "postgres", &c
+		svc.common = v3.DBAASServiceCommon{Name: c.Name, Type: c.Type, Plan: c.Plan, State: c.State}
+	case *v3.DBAASServiceMysql:
+		c := *v
+		c.Type = "mysql"
+		c.Users = slices.Clone(c.Users)
+		for i, u := range c.Users {
+			svc.secrets[u.Username] = v3.DBAASUserMysqlSecrets{Username: u.Username, Password: u.Password}
+			c.Users[i].Password = ""
+		}
+		svc.kind, svc.service = "mysql", &c
+		svc.common = v3.DBAASServiceCommon{Name: c.Name, Type: c.Type, Plan: c.Plan, State: c.State}
+	case *v3.DBAASServiceRedis:
+		c := *v
+		c.Type = "redis"
+		c.Users = slices.Clone(c.Users)
+		for i, u := range c.Users {
+			svc.secrets[u.Username] = v3.DBAASUserRedisSecrets{Username: u.Username, Password: u.Password}
+			c.Users[i].Password = ""
+		}
+		svc.kind, svc.service = "redis", &c
+		svc.common = v3.DBAASServiceCommon{Name: c.Name, Type: c.Type, Plan: c.Plan, State: c.State}
+	case *v3.DBAASServiceKafka:
+		c := *v
+		c.Type = "kafka"
+		c.Users = slices.Clone(c.Users)
+		for i, u := range c.Users {
+			svc.secrets[u.Username] = v3.DBAASUserKafkaSecrets{
+				Username:         u.Username,
+				Password:         u.Password,
+				AccessCert:       u.AccessCert,
+				AccessCertExpiry: u.AccessCertExpiry,
+				AccessKey:        u.AccessKey,
+			}
+			c.Users[i].Password, c.Users[i].AccessKey = "", ""
+		}
+		svc.kind, svc.service = "kafka", &c
+		svc.common = v3.DBAASServiceCommon{Name: c.Name, Type: c.Type, Plan: c.Plan, State: c.State}
+	case *v3.DBAASServiceOpensearch:
+		c := *v
+		c.Type = "opensearch"
+		c.Users = slices.Clone(c.Users)
+		for i, u := range c.Users {
+			svc.secrets[u.Username] = v3.DBAASUserOpensearchSecrets{Username: u.Username, Password: u.Password}
+			c.Users[i].Password = ""
+		}
+		svc.kind, svc.service = "opensearch", &c
+		svc.common = v3.DBAASServiceCommon{Name: c.Name, Type: c.Type, Plan: c.Plan, State: c.State}
+	default:
+		panic(fmt.Sprintf("testserver: unsupported DBaaS service type %T", service))
+	}
+	if svc.common.State == "" {
+		svc.common.State = v3.EnumServiceStateRunning
+	}
+	svc.setState(svc.common.State)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dbaasServices[string(svc.common.Name)] = svc
+}
+
+// SetDBAASServiceState sets the state of a DBaaS service added with
+// AddDBAASService. It panics if the service doesn't exist.
+func (s *Server) SetDBAASServiceState(name string, state v3.EnumServiceState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.dbaasServices[name]
+	if !ok {
+		panic(fmt.Sprintf("testserver: DBaaS service %q not found", name))
+	}
+
+	svc.setState(state)
+}
+
+// setState sets the state of the service in both its representations.
+func (svc *dbaasService) setState(state v3.EnumServiceState) {
+	svc.common.State = state
+	switch v := svc.service.(type) {
+	case *v3.DBAASServicePG:
+		v.State = state
+	case *v3.DBAASServiceMysql:
+		v.State = state
+	case *v3.DBAASServiceRedis:
+		v.State = state
+	case *v3.DBAASServiceKafka:
+		v.State = state
+	case *v3.DBAASServiceOpensearch:
+		v.State = state
+	}
+}
+
+// AddDBAASServiceLogs appends log entries to those of a DBaaS service added
+// with AddDBAASService. It panics if the service doesn't exist.
+func (s *Server) AddDBAASServiceLogs(name string, logs ...v3.DBAASServiceLogsLogs) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.dbaasServices[name]
+	if !ok {
+		panic(fmt.Sprintf("testserver: DBaaS service %q not found", name))
+	}
+
+	svc.logs = append(svc.logs, logs...)
+}
+
+// SetDBAASServiceLogs replaces the log entries of a DBaaS service added with
+// AddDBAASService, e.g. to reorder the entries sharing a time as the API may.
+// It panics if the service doesn't exist.
+func (s *Server) SetDBAASServiceLogs(name string, logs ...v3.DBAASServiceLogsLogs) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.dbaasServices[name]
+	if !ok {
+		panic(fmt.Sprintf("testserver: DBaaS service %q not found", name))
+	}
+
+	svc.logs = slices.Clone(logs)
+}
+
+func (s *Server) listDBAASServices(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	services := []v3.DBAASServiceCommon{}
+	for _, svc := range s.dbaasServices {
+		services = append(services, svc.common)
+	}
+
+	writeJSON(w, v3.ListDBAASServicesResponse{DBAASServices: services})
+}
+
+func (s *Server) getDBAASService(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		svc, ok := s.dbaasServices[r.PathValue("name")]
+		if !ok || svc.kind != kind {
+			writeError(w, http.StatusNotFound, "DBaaS service not found")
+			return
+		}
+
+		writeJSON(w, svc.service)
+	}
+}
+
+func (s *Server) revealDBAASUserPassword(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		svc, ok := s.dbaasServices[r.PathValue("name")]
+		if !ok || svc.kind != kind {
+			writeError(w, http.StatusNotFound, "DBaaS service not found")
+			return
+		}
+		secrets, ok := svc.secrets[r.PathValue("username")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "DBaaS user not found")
+			return
+		}
+
+		writeJSON(w, secrets)
+	}
+}
+
+func (s *Server) getDBAASCACertificate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dbaasCACertificate == "" {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "testserver DBaaS CA"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(10 * 365 * 24 * time.Hour),
+			KeyUsage:              x509.KeyUsageCertSign,
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}
+		cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		s.dbaasCACertificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+	}
+
+	writeJSON(w, v3.GetDBAASCACertificateResponse{Certificate: s.dbaasCACertificate})
+}
+
+func (s *Server) getDBAASServiceLogs(w http.ResponseWriter, r *http.Request) {
+	var req v3.GetDBAASServiceLogsRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	svc, ok := s.dbaasServices[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "DBaaS service not found")
+		return
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	// The offset is the one of the last entry of the previous page, excluded.
+	offset := -1
+	if req.Offset != "" {
+		var err error
+		if offset, err = strconv.Atoi(req.Offset); err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+
+	var start, end int
+	if req.SortOrder == v3.EnumSortOrderAsc {
+		start = min(offset+1, len(svc.logs))
+		end = min(start+limit, len(svc.logs))
+	} else {
+		end = len(svc.logs)
+		if offset >= 0 {
+			end = min(offset, end)
+		}
+		start = max(end-limit, 0)
+	}
+
+	resp := v3.DBAASServiceLogs{Logs: slices.Clone(svc.logs[start:end])}
+	if len(svc.logs) > 0 {
+		resp.FirstLogOffset = "0"
+	}
+	if start < end {
+		if req.SortOrder == v3.EnumSortOrderAsc {
+			resp.Offset = strconv.Itoa(end - 1)
+		} else {
+			slices.Reverse(resp.Logs)
+			resp.Offset = strconv.Itoa(start)
+		}
+	}
+
+	writeJSON(w, resp)
+}
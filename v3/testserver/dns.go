@@ -0,0 +1,277 @@
+package testserver
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+func (s *Server) registerDNS(mux *http.ServeMux) {
+	mux.HandleFunc("GET /dns-domain", s.listDNSDomains)
+	mux.HandleFunc("POST /dns-domain", s.createDNSDomain)
+	mux.HandleFunc("GET /dns-domain/{id}", s.getDNSDomain)
+	mux.HandleFunc("DELETE /dns-domain/{id}", s.deleteDNSDomain)
+	mux.HandleFunc("GET /dns-domain/{id}/zone", s.getDNSDomainZoneFile)
+	mux.HandleFunc("GET /dns-domain/{id}/record", s.listDNSDomainRecords)
+	mux.HandleFunc("POST /dns-domain/{id}/record", s.createDNSDomainRecord)
+	mux.HandleFunc("GET /dns-domain/{id}/record/{record}", s.getDNSDomainRecord)
+	mux.HandleFunc("PUT /dns-domain/{id}/record/{record}", s.updateDNSDomainRecord)
+	mux.HandleFunc("DELETE /dns-domain/{id}/record/{record}", s.deleteDNSDomainRecord)
+}
+
+func (s *Server) listDNSDomains(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domains := []v3.DNSDomain{}
+	for _, d := range s.dnsDomains {
+		domains = append(domains, *d)
+	}
+
+	writeJSON(w, v3.ListDNSDomainsResponse{DNSDomains: domains})
+}
+
+func (s *Server) createDNSDomain(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateDNSDomainRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.UnicodeName == "" {
+		writeError(w, http.StatusBadRequest, "missing unicode-name")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, d := range s.dnsDomains {
+		if d.UnicodeName == req.UnicodeName {
+			writeError(w, http.StatusConflict, "domain already exists")
+			return
+		}
+	}
+
+	domain := &v3.DNSDomain{
+		ID:          newUUID(),
+		UnicodeName: req.UnicodeName,
+		CreatedAT:   time.Now().UTC(),
+	}
+	s.dnsDomains[domain.ID] = domain
+	s.dnsRecords[domain.ID] = map[v3.UUID]*v3.DNSDomainRecord{}
+
+	// Like the real API, new domains come with their apex SOA and NS records.
+	for _, rec := range []v3.DNSDomainRecord{
+		{Type: v3.DNSDomainRecordTypeSOA, Content: "ns1.exoscale.ch. support.exoscale.ch. 1 10800 3600 604800 3600", Ttl: 3600},
+		{Type: v3.DNSDomainRecordTypeNS, Content: "ns1.exoscale.ch.", Ttl: 3600},
+	} {
+		rec.ID = newUUID()
+		rec.CreatedAT = domain.CreatedAT
+		s.dnsRecords[domain.ID][rec.ID] = &rec
+	}
+
+	writeJSON(w, domain)
+}
+
+func (s *Server) getDNSDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domain, ok := s.dnsDomains[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+
+	writeJSON(w, domain)
+}
+
+func (s *Server) deleteDNSDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.dnsDomains[id]; !ok {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+	delete(s.dnsDomains, id)
+	delete(s.dnsRecords, id)
+
+	s.newOperation(w, "delete-dns-domain", "/dns-domain/"+id.String(), id, nil)
+}
+
+func (s *Server) getDNSDomainZoneFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	domain, ok := s.dnsDomains[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+
+	var lines []string
+	for _, rec := range s.sortedRecords(id) {
+		name := rec.Name
+		if name == "" {
+			name = "@"
+		}
+		content := rec.Content
+		switch rec.Type {
+		case v3.DNSDomainRecordTypeCNAME, v3.DNSDomainRecordTypeNS, v3.DNSDomainRecordTypeALIAS,
+			v3.DNSDomainRecordTypeMX, v3.DNSDomainRecordTypeSRV:
+			// Names are stored without the trailing dot, which makes them absolute in a zone file.
+			if !strings.HasSuffix(content, ".") {
+				content += "."
+			}
+		}
+		if rec.Type == v3.DNSDomainRecordTypeMX || rec.Type == v3.DNSDomainRecordTypeSRV {
+			content = fmt.Sprintf("%d %s", rec.Priority, content)
+		}
+		if rec.Type == v3.DNSDomainRecordTypeTXT && !strings.HasPrefix(content, `"`) {
+			content = fmt.Sprintf("%q", content)
+		}
+		lines = append(lines, fmt.Sprintf("%s %d IN %s %s", name, rec.Ttl, rec.Type, content))
+	}
+
+	writeJSON(w, v3.GetDNSDomainZoneFileResponse{
+		ZoneFile: fmt.Sprintf("$ORIGIN %s.\n%s\n", domain.UnicodeName, strings.Join(lines, "\n")),
+	})
+}
+
+func (s *Server) listDNSDomainRecords(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.dnsDomains[id]; !ok {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+
+	records := []v3.DNSDomainRecord{}
+	for _, rec := range s.sortedRecords(id) {
+		records = append(records, *rec)
+	}
+
+	writeJSON(w, v3.ListDNSDomainRecordsResponse{DNSDomainRecords: records})
+}
+
+func (s *Server) createDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateDNSDomainRecordRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.dnsDomains[id]; !ok {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+
+	ttl := req.Ttl
+	if ttl == 0 {
+		ttl = 3600
+	}
+
+	now := time.Now().UTC()
+	rec := &v3.DNSDomainRecord{
+		ID:        newUUID(),
+		Name:      req.Name,
+		Type:      v3.DNSDomainRecordType(req.Type),
+		Content:   req.Content,
+		Priority:  req.Priority,
+		Ttl:       ttl,
+		CreatedAT: now,
+		UpdatedAT: now,
+	}
+	s.dnsRecords[id][rec.ID] = rec
+
+	s.newOperation(w, "create-dns-domain-record", "/dns-domain/"+id.String()+"/record/"+rec.ID.String(), rec.ID, nil)
+}
+
+func (s *Server) getDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.dnsRecords[v3.UUID(r.PathValue("id"))][v3.UUID(r.PathValue("record"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "record not found")
+		return
+	}
+
+	writeJSON(w, rec)
+}
+
+func (s *Server) updateDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	var req v3.UpdateDNSDomainRecordRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	rec, ok := s.dnsRecords[id][v3.UUID(r.PathValue("record"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "record not found")
+		return
+	}
+
+	if req.Name != "" {
+		rec.Name = req.Name
+	}
+	if req.Content != "" {
+		rec.Content = req.Content
+	}
+	if req.Ttl != 0 {
+		rec.Ttl = req.Ttl
+	}
+	if req.Priority != 0 {
+		rec.Priority = req.Priority
+	}
+	rec.UpdatedAT = time.Now().UTC()
+
+	s.newOperation(w, "update-dns-domain-record", "/dns-domain/"+id.String()+"/record/"+rec.ID.String(), rec.ID, nil)
+}
+
+func (s *Server) deleteDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	recordID := v3.UUID(r.PathValue("record"))
+	if _, ok := s.dnsRecords[id][recordID]; !ok {
+		writeError(w, http.StatusNotFound, "record not found")
+		return
+	}
+	delete(s.dnsRecords[id], recordID)
+
+	s.newOperation(w, "delete-dns-domain-record", "/dns-domain/"+id.String()+"/record/"+recordID.String(), recordID, nil)
+}
+
+// sortedRecords returns the records of a domain in a stable order.
+// It must be called with the Server lock held.
+func (s *Server) sortedRecords(domainID v3.UUID) []*v3.DNSDomainRecord {
+	records := make([]*v3.DNSDomainRecord, 0, len(s.dnsRecords[domainID]))
+	for _, rec := range s.dnsRecords[domainID] {
+		records = append(records, rec)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].CreatedAT.Equal(records[j].CreatedAT) {
+			return records[i].ID < records[j].ID
+		}
+		return records[i].CreatedAT.Before(records[j].CreatedAT)
+	})
+
+	return records
+}
@@ -0,0 +1,51 @@
+package testserver
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+func (s *Server) registerEvents(mux *http.ServeMux) {
+	mux.HandleFunc("GET /event", s.listEvents)
+}
+
+// AddEvents adds audit events to the Server, returned by ListEvents in
+// chronological order.
+func (s *Server) AddEvents(events ...v3.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, events...)
+	sort.SliceStable(s.events, func(i, j int) bool {
+		return s.events[i].Timestamp.Before(s.events[j].Timestamp)
+	})
+}
+
+// listEvents returns the events of the [from, to) time range.
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request) {
+	var from, to time.Time
+	for name, t := range map[string]*time.Time{"from": &from, "to": &to} {
+		if v := r.URL.Query().Get(name); v != "" {
+			var err error
+			if *t, err = time.Parse(time.RFC3339, v); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid "+name)
+				return
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := []v3.Event{}
+	for _, e := range s.events {
+		if (from.IsZero() || !e.Timestamp.Before(from)) && (to.IsZero() || e.Timestamp.Before(to)) {
+			events = append(events, e)
+		}
+	}
+
+	writeJSON(w, events)
+}
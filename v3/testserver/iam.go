@@ -0,0 +1,325 @@
+package testserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+// apiKey is an IAM API key accepted by the Server.
+type apiKey struct {
+	key    v3.IAMAPIKey
+	secret string
+}
+
+func (s *Server) registerIAM(mux *http.ServeMux) {
+	mux.HandleFunc("GET /iam-organization-policy", s.getIAMOrganizationPolicy)
+	mux.HandleFunc("PUT /iam-organization-policy", s.updateIAMOrganizationPolicy)
+	mux.HandleFunc("GET /iam-role", s.listIAMRoles)
+	mux.HandleFunc("POST /iam-role", s.createIAMRole)
+	mux.HandleFunc("GET /iam-role/{id}", s.getIAMRole)
+	mux.HandleFunc("PUT /iam-role/{id}", s.updateIAMRole)
+	mux.HandleFunc("DELETE /iam-role/{id}", s.deleteIAMRole)
+	mux.HandleFunc("GET /api-key", s.listAPIKeys)
+	mux.HandleFunc("POST /api-key", s.createAPIKey)
+	mux.HandleFunc("GET /api-key/{id}", s.getAPIKey)
+	mux.HandleFunc("DELETE /api-key/{id}", s.deleteAPIKey)
+}
+
+func (s *Server) getIAMOrganizationPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, s.iamOrgPolicy)
+}
+
+func (s *Server) updateIAMOrganizationPolicy(w http.ResponseWriter, r *http.Request) {
+	var req v3.IAMPolicy
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if !validIAMPolicy(w, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.newOperation(w, "update-iam-organization-policy", "/iam-organization-policy", "", func() {
+		s.iamOrgPolicy = &req
+	})
+}
+
+func (s *Server) listIAMRoles(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	roles := []v3.IAMRole{}
+	for _, role := range s.iamRoles {
+		roles = append(roles, *role)
+	}
+
+	writeJSON(w, v3.ListIAMRolesResponse{IAMRoles: roles})
+}
+
+func (s *Server) createIAMRole(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateIAMRoleRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "missing name")
+		return
+	}
+	if req.Policy != nil && !validIAMPolicy(w, req.Policy) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	editable := true
+	if req.Editable != nil {
+		editable = *req.Editable
+	}
+
+	role := &v3.IAMRole{
+		ID:          newUUID(),
+		Name:        req.Name,
+		Description: req.Description,
+		Editable:    &editable,
+		Labels:      req.Labels,
+		Permissions: req.Permissions,
+		Policy:      req.Policy,
+	}
+	if role.Policy == nil {
+		role.Policy = &v3.IAMPolicy{
+			DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyDeny,
+			Services:               map[string]v3.IAMServicePolicy{},
+		}
+	}
+
+	s.newOperation(w, "create-iam-role", "/iam-role/"+role.ID.String(), role.ID, func() {
+		s.iamRoles[role.ID] = role
+	})
+}
+
+func (s *Server) getIAMRole(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, ok := s.iamRoles[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "IAM role not found")
+		return
+	}
+
+	writeJSON(w, role)
+}
+
+// updateIAMRole handles the IAM role update and policy update.
+func (s *Server) updateIAMRole(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+	if action == "policy" {
+		s.updateIAMRolePolicy(w, r, id)
+		return
+	}
+
+	var req v3.UpdateIAMRoleRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, ok := s.iamRoles[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "IAM role not found")
+		return
+	}
+
+	s.newOperation(w, "update-iam-role", "/iam-role/"+id.String(), id, func() {
+		if req.Description != "" {
+			role.Description = req.Description
+		}
+		if req.Labels != nil {
+			role.Labels = req.Labels
+		}
+		if req.Permissions != nil {
+			role.Permissions = req.Permissions
+		}
+	})
+}
+
+func (s *Server) updateIAMRolePolicy(w http.ResponseWriter, r *http.Request, id v3.UUID) {
+	var req v3.IAMPolicy
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if !validIAMPolicy(w, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	role, ok := s.iamRoles[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "IAM role not found")
+		return
+	}
+	if role.Editable != nil && !*role.Editable {
+		writeError(w, http.StatusForbidden, "IAM role policy is not editable")
+		return
+	}
+
+	s.newOperation(w, "update-iam-role-policy", "/iam-role/"+id.String(), id, func() {
+		role.Policy = &req
+	})
+}
+
+func (s *Server) deleteIAMRole(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.iamRoles[id]; !ok {
+		writeError(w, http.StatusNotFound, "IAM role not found")
+		return
+	}
+	delete(s.iamRoles, id)
+
+	s.newOperation(w, "delete-iam-role", "/iam-role/"+id.String(), id, nil)
+}
+
+func (s *Server) listAPIKeys(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := []v3.IAMAPIKey{}
+	for _, k := range s.apiKeys {
+		keys = append(keys, k.key)
+	}
+
+	writeJSON(w, v3.ListAPIKeysResponse{APIKeys: keys})
+}
+
+func (s *Server) createAPIKey(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateAPIKeyRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.RoleID == "" {
+		writeError(w, http.StatusBadRequest, "missing name or role-id")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.iamRoles[req.RoleID]; !ok {
+		writeError(w, http.StatusNotFound, "IAM role not found")
+		return
+	}
+
+	k := &apiKey{
+		key: v3.IAMAPIKey{
+			Key:    "EXO" + randomString(12, hex.EncodeToString),
+			Name:   req.Name,
+			RoleID: req.RoleID,
+		},
+		secret: randomString(32, base64.RawURLEncoding.EncodeToString),
+	}
+	s.apiKeys[k.key.Key] = k
+
+	writeJSON(w, v3.IAMAPIKeyCreated{
+		Key:    k.key.Key,
+		Name:   k.key.Name,
+		RoleID: k.key.RoleID,
+		Secret: k.secret,
+	})
+}
+
+func (s *Server) getAPIKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.apiKeys[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "API key not found")
+		return
+	}
+
+	writeJSON(w, k.key)
+}
+
+func (s *Server) deleteAPIKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.apiKeys[id]; !ok {
+		writeError(w, http.StatusNotFound, "API key not found")
+		return
+	}
+	delete(s.apiKeys, id)
+
+	s.newOperation(w, "delete-api-key", "/api-key/"+id, "", nil)
+}
+
+// secretOf returns the secret of an API key accepted by the Server.
+func (s *Server) secretOf(key string) (string, bool) {
+	if key == s.apiKey {
+		return s.apiSecret, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.apiKeys[key]
+	if !ok {
+		return "", false
+	}
+
+	return k.secret, true
+}
+
+func randomString(n int, encode func([]byte) string) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+
+	return encode(b)
+}
+
+// validIAMPolicy checks the structure of an IAM policy, writing an error
+// response if it is invalid. Rule expressions are not checked.
+func validIAMPolicy(w http.ResponseWriter, policy *v3.IAMPolicy) bool {
+	switch policy.DefaultServiceStrategy {
+	case v3.IAMPolicyDefaultServiceStrategyAllow, v3.IAMPolicyDefaultServiceStrategyDeny:
+	default:
+		writeError(w, http.StatusBadRequest, "invalid default-service-strategy")
+		return false
+	}
+
+	for name, service := range policy.Services {
+		switch service.Type {
+		case v3.IAMServicePolicyTypeAllow, v3.IAMServicePolicyTypeDeny, v3.IAMServicePolicyTypeRules:
+		default:
+			writeError(w, http.StatusBadRequest, "invalid type of service "+name)
+			return false
+		}
+
+		for _, rule := range service.Rules {
+			if rule.Expression == "" {
+				writeError(w, http.StatusBadRequest, "missing expression in rules of service "+name)
+				return false
+			}
+		}
+	}
+
+	return true
+}
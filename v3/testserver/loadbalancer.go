@@ -0,0 +1,202 @@
+package testserver
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+func (s *Server) registerLoadBalancer(mux *http.ServeMux) {
+	mux.HandleFunc("POST /load-balancer", s.createLoadBalancer)
+	mux.HandleFunc("GET /load-balancer/{id}", s.getLoadBalancer)
+	mux.HandleFunc("DELETE /load-balancer/{id}", s.deleteLoadBalancer)
+	mux.HandleFunc("POST /load-balancer/{id}/service", s.addLoadBalancerService)
+	mux.HandleFunc("GET /load-balancer/{id}/service/{service}", s.getLoadBalancerService)
+	mux.HandleFunc("DELETE /load-balancer/{id}/service/{service}", s.deleteLoadBalancerService)
+}
+
+// SetHealthcheck sets the function deciding whether the members of the Instance
+// Pools behind Load Balancer services pass the healthchecks. By default, running
+// members pass them.
+func (s *Server) SetHealthcheck(fn func(instance *v3.Instance) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.healthcheck = fn
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateLoadBalancerRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "missing name")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lb := &v3.LoadBalancer{
+		ID:          newUUID(),
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		IP:          s.nextPublicIP(),
+		CreatedAT:   time.Now().UTC(),
+		State:       v3.LoadBalancerStateCreating,
+	}
+	s.loadBalancers[lb.ID] = lb
+
+	s.newOperation(w, "create-load-balancer", "/load-balancer/"+lb.ID.String(), lb.ID, func() {
+		lb.State = v3.LoadBalancerStateRunning
+	})
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lb, ok := s.loadBalancers[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+
+	res := *lb
+	res.Services = make([]v3.LoadBalancerService, len(lb.Services))
+	for i, svc := range lb.Services {
+		res.Services[i] = s.withHealthcheckStatus(svc)
+	}
+
+	writeJSON(w, res)
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.loadBalancers[id]; !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	delete(s.loadBalancers, id)
+
+	s.newOperation(w, "delete-load-balancer", "/load-balancer/"+id.String(), id, nil)
+}
+
+func (s *Server) addLoadBalancerService(w http.ResponseWriter, r *http.Request) {
+	var req v3.AddServiceToLoadBalancerRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.InstancePool == nil || req.Port == 0 {
+		writeError(w, http.StatusBadRequest, "missing name, instance pool or port")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	if _, ok := s.instancePools[req.InstancePool.ID]; !ok {
+		writeError(w, http.StatusNotFound, "instance pool not found")
+		return
+	}
+
+	svc := v3.LoadBalancerService{
+		ID:           newUUID(),
+		Name:         req.Name,
+		Description:  req.Description,
+		Healthcheck:  req.Healthcheck,
+		InstancePool: &v3.InstancePool{ID: req.InstancePool.ID},
+		Port:         req.Port,
+		TargetPort:   req.TargetPort,
+		Protocol:     v3.LoadBalancerServiceProtocol(req.Protocol),
+		Strategy:     v3.LoadBalancerServiceStrategy(req.Strategy),
+		State:        v3.LoadBalancerServiceStateRunning,
+	}
+	lb.Services = append(lb.Services, svc)
+
+	s.newOperation(w, "add-service-to-load-balancer", "/load-balancer/"+id.String()+"/service/"+svc.ID.String(), svc.ID, nil)
+}
+
+func (s *Server) getLoadBalancerService(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lb, ok := s.loadBalancers[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	i := slices.IndexFunc(lb.Services, func(svc v3.LoadBalancerService) bool {
+		return svc.ID == v3.UUID(r.PathValue("service"))
+	})
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "load balancer service not found")
+		return
+	}
+
+	writeJSON(w, s.withHealthcheckStatus(lb.Services[i]))
+}
+
+func (s *Server) deleteLoadBalancerService(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	lb, ok := s.loadBalancers[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	serviceID := v3.UUID(r.PathValue("service"))
+	if !slices.ContainsFunc(lb.Services, func(svc v3.LoadBalancerService) bool { return svc.ID == serviceID }) {
+		writeError(w, http.StatusNotFound, "load balancer service not found")
+		return
+	}
+	lb.Services = slices.DeleteFunc(lb.Services, func(svc v3.LoadBalancerService) bool {
+		return svc.ID == serviceID
+	})
+
+	s.newOperation(w, "delete-load-balancer-service", "/load-balancer/"+id.String()+"/service/"+serviceID.String(), serviceID, nil)
+}
+
+// withHealthcheckStatus returns a Load Balancer service with the healthcheck
+// status of the members of its Instance Pool.
+// It must be called with the Server lock held.
+func (s *Server) withHealthcheckStatus(svc v3.LoadBalancerService) v3.LoadBalancerService {
+	pool, ok := s.instancePools[svc.InstancePool.ID]
+	if !ok {
+		return svc
+	}
+
+	svc.HealthcheckStatus = []v3.LoadBalancerServerStatus{}
+	for _, member := range pool.Instances {
+		instance, ok := s.instances[member.ID]
+		if !ok {
+			continue
+		}
+
+		status := v3.LoadBalancerServerStatusStatusFailure
+		if instance.State == v3.InstanceStateRunning && (s.healthcheck == nil || s.healthcheck(instance)) {
+			status = v3.LoadBalancerServerStatusStatusSuccess
+		}
+		svc.HealthcheckStatus = append(svc.HealthcheckStatus, v3.LoadBalancerServerStatus{
+			PublicIP: instance.PublicIP,
+			Status:   status,
+		})
+	}
+
+	return svc
+}
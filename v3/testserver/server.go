@@ -0,0 +1,426 @@
+// Package testserver provides an in-memory fake of the Exoscale API, to test
+// code using the v3 client without reaching the real API.
+//
+// The Server implements a stateful subset of the API (zones, async operations,
+// Compute instances, Instance Pools, Network Load Balancers, Security Groups,
+// Private Networks, SSH keys, DNS, SKS, IAM roles, API keys, DBaaS services
+// and their logs, audit events), verifies the request signatures and allows
+// injecting faults.
+package testserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+const (
+	defaultAPIKey    = "EXOtestserver"
+	defaultAPISecret = "testserver-secret"
+	defaultZone      = v3.ZoneName("ch-gva-2")
+)
+
+// Fault represents an error response injected by the Server.
+type Fault struct {
+	// Method is the HTTP method of the requests to fail, any method if empty.
+	Method string
+	// Path is the path prefix of the requests to fail, any path if empty.
+	Path string
+	// StatusCode is the HTTP status code of the error response.
+	StatusCode int
+	// Message is the error message of the error response.
+	Message string
+	// Header holds additional headers of the error response, e.g. Retry-After.
+	Header http.Header
+	// Delay is the delay to wait before responding.
+	Delay time.Duration
+	// Times is the number of requests to fail, every matching request if 0.
+	Times int
+}
+
+// ServerOpt represents a function setting a Server option.
+type ServerOpt func(*Server)
+
+// ServerOptWithCredentials returns a ServerOpt overriding the API credentials
+// accepted by the Server.
+func ServerOptWithCredentials(apiKey, apiSecret string) ServerOpt {
+	return func(s *Server) {
+		s.apiKey = apiKey
+		s.apiSecret = apiSecret
+	}
+}
+
+// ServerOptWithZone returns a ServerOpt overriding the zone served by the Server.
+func ServerOptWithZone(zone v3.ZoneName) ServerOpt {
+	return func(s *Server) {
+		s.zone = zone
+	}
+}
+
+// ServerOptWithOperationPolls returns a ServerOpt setting the number of API
+// requests during which an async operation stays pending before succeeding.
+func ServerOptWithOperationPolls(n int) ServerOpt {
+	return func(s *Server) {
+		s.operationPolls = n
+	}
+}
+
+// Server represents a fake Exoscale API server.
+type Server struct {
+	*httptest.Server
+
+	apiKey         string
+	apiSecret      string
+	zone           v3.ZoneName
+	operationPolls int
+
+	mu              sync.Mutex
+	faults          []*Fault
+	operations      map[v3.UUID]*operation
+	instances       map[v3.UUID]*v3.Instance
+	instancePools   map[v3.UUID]*v3.InstancePool
+	loadBalancers   map[v3.UUID]*v3.LoadBalancer
+	healthcheck     func(*v3.Instance) bool
+	publicIPs       int
+	securityGroups  map[v3.UUID]*v3.SecurityGroup
+	privateNetworks map[v3.UUID]*v3.PrivateNetwork
+	sshKeys         map[string]*v3.SSHKey
+	dnsDomains      map[v3.UUID]*v3.DNSDomain
+	dnsRecords      map[v3.UUID]map[v3.UUID]*v3.DNSDomainRecord
+	sksClusters     map[v3.UUID]*v3.SKSCluster
+	sksDeprecated   map[v3.UUID][]v3.SKSClusterDeprecatedResource
+	iamOrgPolicy    *v3.IAMPolicy
+	iamRoles        map[v3.UUID]*v3.IAMRole
+	apiKeys         map[string]*apiKey
+	dbaasServices   map[string]*dbaasService
+	events          []v3.Event
+	// dbaasCACertificate is generated on the first request.
+	dbaasCACertificate string
+}
+
+type operation struct {
+	op    v3.Operation
+	polls int
+	// done is called when the operation succeeds.
+	done func()
+}
+
+// New returns a started Server. The caller must call Close when finished.
+func New(opts ...ServerOpt) *Server {
+	s := &Server{
+		apiKey:          defaultAPIKey,
+		apiSecret:       defaultAPISecret,
+		zone:            defaultZone,
+		operationPolls:  1,
+		operations:      make(map[v3.UUID]*operation),
+		instances:       make(map[v3.UUID]*v3.Instance),
+		instancePools:   make(map[v3.UUID]*v3.InstancePool),
+		loadBalancers:   make(map[v3.UUID]*v3.LoadBalancer),
+		securityGroups:  make(map[v3.UUID]*v3.SecurityGroup),
+		privateNetworks: make(map[v3.UUID]*v3.PrivateNetwork),
+		sshKeys:         make(map[string]*v3.SSHKey),
+		dnsDomains:      make(map[v3.UUID]*v3.DNSDomain),
+		dnsRecords:      make(map[v3.UUID]map[v3.UUID]*v3.DNSDomainRecord),
+		sksClusters:     make(map[v3.UUID]*v3.SKSCluster),
+		sksDeprecated:   make(map[v3.UUID][]v3.SKSClusterDeprecatedResource),
+		iamOrgPolicy: &v3.IAMPolicy{
+			DefaultServiceStrategy: v3.IAMPolicyDefaultServiceStrategyAllow,
+			Services:               map[string]v3.IAMServicePolicy{},
+		},
+		iamRoles:      make(map[v3.UUID]*v3.IAMRole),
+		apiKeys:       make(map[string]*apiKey),
+		dbaasServices: make(map[string]*dbaasService),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /zone", s.listZones)
+	mux.HandleFunc("GET /operation/{id}", s.getOperation)
+	s.registerCompute(mux)
+	s.registerLoadBalancer(mux)
+	s.registerDNS(mux)
+	s.registerSKS(mux)
+	s.registerIAM(mux)
+	s.registerDBAAS(mux)
+	s.registerEvents(mux)
+
+	s.Server = httptest.NewServer(s.middleware(mux))
+
+	return s
+}
+
+// Endpoint returns the Server API endpoint.
+func (s *Server) Endpoint() v3.Endpoint {
+	return v3.Endpoint(s.URL)
+}
+
+// Credentials returns credentials accepted by the Server.
+func (s *Server) Credentials() *credentials.Credentials {
+	return credentials.NewStaticCredentials(s.apiKey, s.apiSecret)
+}
+
+// Client returns a v3.Client configured to use the Server, polling async
+// operations every 10ms.
+func (s *Server) Client(opts ...v3.ClientOpt) (*v3.Client, error) {
+	opts = append([]v3.ClientOpt{
+		v3.ClientOptWithEndpoint(s.Endpoint()),
+		v3.ClientOptWithHTTPClient(s.Server.Client()),
+		v3.ClientOptWithPollingInterval(10 * time.Millisecond),
+	}, opts...)
+
+	return v3.NewClient(s.Credentials(), opts...)
+}
+
+// InjectFault makes the Server fail the requests matching the Fault.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if f := s.matchFault(r); f != nil {
+			if f.Delay > 0 {
+				select {
+				case <-time.After(f.Delay):
+				case <-r.Context().Done():
+					return
+				}
+			}
+			if f.StatusCode != 0 {
+				for k, v := range f.Header {
+					w.Header()[k] = v
+				}
+				writeError(w, f.StatusCode, f.Message)
+				return
+			}
+		}
+
+		if err := s.verifySignature(r); err != nil {
+			writeError(w, http.StatusForbidden, err.Error())
+			return
+		}
+
+		s.advanceOperations()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// advanceOperations moves the pending async operations forward, completing
+// those pending for more than the configured number of requests.
+func (s *Server) advanceOperations() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, o := range s.operations {
+		if o.op.State != v3.OperationStatePending {
+			continue
+		}
+
+		o.polls++
+		if o.polls > s.operationPolls {
+			o.op.State = v3.OperationStateSuccess
+			if o.done != nil {
+				o.done()
+			}
+		}
+	}
+}
+
+func (s *Server) matchFault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+
+		return f
+	}
+
+	return nil
+}
+
+// verifySignature verifies the EXO2-HMAC-SHA256 request signature.
+func (s *Server) verifySignature(r *http.Request) error {
+	auth, ok := strings.CutPrefix(r.Header.Get("Authorization"), "EXO2-HMAC-SHA256 ")
+	if !ok {
+		return fmt.Errorf("missing or invalid authorization header")
+	}
+
+	pragmas := make(map[string]string)
+	for _, part := range strings.Split(auth, ",") {
+		k, v, _ := strings.Cut(part, "=")
+		pragmas[k] = v
+	}
+
+	secret, ok := s.secretOf(pragmas["credential"])
+	if !ok {
+		return fmt.Errorf("invalid API key")
+	}
+
+	expires, err := strconv.ParseInt(pragmas["expires"], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid signature expiration")
+	}
+	if time.Unix(expires, 0).Before(time.Now()) {
+		return fmt.Errorf("expired signature")
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("error reading request body: %w", err)
+	}
+	r.Body = io.NopCloser(strings.NewReader(string(body)))
+
+	var (
+		names  []string
+		values string
+	)
+	if pragmas["signed-query-args"] != "" {
+		names = strings.Split(pragmas["signed-query-args"], ";")
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values += r.URL.Query().Get(name)
+	}
+
+	msg := strings.Join([]string{
+		fmt.Sprintf("%s %s", r.Method, r.URL.EscapedPath()),
+		string(body),
+		values,
+		"",
+		pragmas["expires"],
+	}, "\n")
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(msg))
+	expected := base64.StdEncoding.EncodeToString(h.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(pragmas["signature"])) {
+		return fmt.Errorf("invalid request signature")
+	}
+
+	return nil
+}
+
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, v3.ListZonesResponse{
+		Zones: []v3.Zone{{Name: s.zone, APIEndpoint: s.Endpoint()}},
+	})
+}
+
+func (s *Server) getOperation(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.operations[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "operation not found")
+		return
+	}
+
+	writeJSON(w, o.op)
+}
+
+// newOperation registers a pending async operation referencing a resource and
+// writes it to the response. It must be called with the Server lock held.
+func (s *Server) newOperation(w http.ResponseWriter, command, link string, ref v3.UUID, done func()) {
+	o := &operation{
+		op: v3.Operation{
+			ID:    newUUID(),
+			State: v3.OperationStatePending,
+			Reference: &v3.OperationReference{
+				Command: command,
+				ID:      ref,
+				Link:    link,
+			},
+		},
+		done: done,
+	}
+	if s.operationPolls <= 0 {
+		o.op.State = v3.OperationStateSuccess
+		if done != nil {
+			done()
+		}
+	}
+	s.operations[o.op.ID] = o
+
+	writeJSON(w, o.op)
+}
+
+// nextPublicIP returns a public IP address not assigned yet.
+// It must be called with the Server lock held.
+func (s *Server) nextPublicIP() net.IP {
+	s.publicIPs++
+	return net.IPv4(192, 0, byte(2+s.publicIPs/256), byte(s.publicIPs%256))
+}
+
+func newUUID() v3.UUID {
+	return v3.UUID(uuid.New().String())
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
+// splitAction splits a path value such as "<id>:start" into an ID and an action.
+func splitAction(v string) (v3.UUID, string) {
+	id, action, _ := strings.Cut(v, ":")
+	return v3.UUID(id), action
+}
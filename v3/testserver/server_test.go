@@ -0,0 +1,84 @@
+package testserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/credentials"
+)
+
+func TestServerInstanceLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	s := New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	op, err := client.CreateSecurityGroup(ctx, v3.CreateSecurityGroupRequest{Name: "web"})
+	require.NoError(t, err)
+	op, err = client.Wait(ctx, op, v3.OperationStateSuccess)
+	require.NoError(t, err)
+	sgID := op.Reference.ID
+
+	op, err = client.CreateInstance(ctx, v3.CreateInstanceRequest{
+		Name:           "web-1",
+		DiskSize:       10,
+		InstanceType:   &v3.InstanceType{ID: newUUID()},
+		Template:       &v3.Template{ID: newUUID()},
+		SecurityGroups: []v3.SecurityGroup{{ID: sgID}},
+	})
+	require.NoError(t, err)
+	instanceID := op.Reference.ID
+
+	instance, err := client.WaitInstanceState(ctx, instanceID, v3.InstanceStateRunning)
+	require.NoError(t, err)
+	require.Equal(t, "web-1", instance.Name)
+
+	_, err = client.DeleteSecurityGroup(ctx, sgID)
+	require.ErrorIs(t, err, v3.ErrInvalidRequest)
+
+	_, err = client.GetInstance(ctx, newUUID())
+	require.ErrorIs(t, err, v3.ErrNotFound)
+}
+
+func TestServerFaults(t *testing.T) {
+	ctx := context.Background()
+
+	s := New()
+	defer s.Close()
+
+	client, err := s.Client(v3.ClientOptWithRetryPolicy(v3.RetryPolicy{
+		MaxRetries:  2,
+		MinWait:     time.Millisecond,
+		MaxWait:     time.Millisecond,
+		StatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	require.NoError(t, err)
+
+	s.InjectFault(Fault{Method: http.MethodGet, Path: "/zone", StatusCode: http.StatusServiceUnavailable, Times: 2})
+	_, err = client.ListZones(ctx)
+	require.NoError(t, err)
+
+	s.InjectFault(Fault{Path: "/zone", StatusCode: http.StatusServiceUnavailable, Message: "maintenance"})
+	_, err = client.ListZones(ctx)
+	require.ErrorContains(t, err, "maintenance")
+	s.ClearFaults()
+
+	client, err = v3.NewClient(
+		credentials.NewStaticCredentials(defaultAPIKey, "wrong"),
+		v3.ClientOptWithEndpoint(s.Endpoint()),
+	)
+	require.NoError(t, err)
+	_, err = client.ListZones(ctx)
+
+	var apiErr *v3.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+}
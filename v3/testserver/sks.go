@@ -0,0 +1,501 @@
+package testserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"time"
+
+	v3 "github.com/sauterp/egoscale/v3"
+)
+
+func (s *Server) registerSKS(mux *http.ServeMux) {
+	mux.HandleFunc("GET /sks-cluster", s.listSKSClusters)
+	mux.HandleFunc("POST /sks-cluster", s.createSKSCluster)
+	mux.HandleFunc("GET /sks-cluster/{id}", s.getSKSCluster)
+	mux.HandleFunc("PUT /sks-cluster/{id}", s.updateSKSCluster)
+	mux.HandleFunc("PUT /sks-cluster/{id}/upgrade", s.upgradeSKSCluster)
+	mux.HandleFunc("DELETE /sks-cluster/{id}", s.deleteSKSCluster)
+	mux.HandleFunc("POST /sks-cluster/{id}/nodepool", s.createSKSNodepool)
+	mux.HandleFunc("GET /sks-cluster/{id}/nodepool/{nodepool}", s.getSKSNodepool)
+	mux.HandleFunc("PUT /sks-cluster/{id}/nodepool/{nodepool}", s.updateSKSNodepool)
+	mux.HandleFunc("DELETE /sks-cluster/{id}/nodepool/{nodepool}", s.deleteSKSNodepool)
+	mux.HandleFunc("POST /sks-cluster-kubeconfig/{id}", s.generateSKSClusterKubeconfig)
+	mux.HandleFunc("GET /sks-cluster-version", s.listSKSClusterVersions)
+	mux.HandleFunc("GET /sks-cluster-deprecated-resources/{id}", s.listSKSClusterDeprecatedResources)
+}
+
+// sksVersions are the SKS cluster versions supported by the Server, newest first.
+var sksVersions = []string{"1.31.2", "1.30.6", "1.29.10"}
+
+// SetSKSClusterDeprecatedResources sets the deprecated resources reported for an
+// SKS cluster.
+func (s *Server) SetSKSClusterDeprecatedResources(id v3.UUID, resources []v3.SKSClusterDeprecatedResource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sksDeprecated[id] = resources
+}
+
+func (s *Server) listSKSClusterVersions(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, v3.ListSKSClusterVersionsResponse{SKSClusterVersions: sksVersions})
+}
+
+func (s *Server) listSKSClusterDeprecatedResources(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	if _, ok := s.sksClusters[id]; !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+
+	resources := s.sksDeprecated[id]
+	if resources == nil {
+		resources = []v3.SKSClusterDeprecatedResource{}
+	}
+
+	writeJSON(w, resources)
+}
+
+func (s *Server) listSKSClusters(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clusters := []v3.SKSCluster{}
+	for _, c := range s.sksClusters {
+		clusters = append(clusters, *c)
+	}
+
+	writeJSON(w, v3.ListSKSClustersResponse{SKSClusters: clusters})
+}
+
+func (s *Server) createSKSCluster(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateSKSClusterRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.Version == "" {
+		writeError(w, http.StatusBadRequest, "missing name or version")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cluster := &v3.SKSCluster{
+		ID:          newUUID(),
+		Name:        req.Name,
+		Addons:      req.Addons,
+		AutoUpgrade: req.AutoUpgrade,
+		Cni:         v3.SKSClusterCni(req.Cni),
+		Level:       v3.SKSClusterLevel(req.Level),
+		Labels:      req.Labels,
+		Version:     req.Version,
+		CreatedAT:   time.Now().UTC(),
+		State:       v3.SKSClusterStateCreating,
+	}
+	if req.Description != nil {
+		cluster.Description = *req.Description
+	}
+	cluster.Endpoint = s.URL + "/sks/" + cluster.ID.String()
+	s.sksClusters[cluster.ID] = cluster
+
+	s.newOperation(w, "create-sks-cluster", "/sks-cluster/"+cluster.ID.String(), cluster.ID, func() {
+		cluster.State = v3.SKSClusterStateRunning
+	})
+}
+
+func (s *Server) getSKSCluster(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cluster, ok := s.sksClusters[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+
+	writeJSON(w, cluster)
+}
+
+func (s *Server) updateSKSCluster(w http.ResponseWriter, r *http.Request) {
+	var req v3.UpdateSKSClusterRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	cluster, ok := s.sksClusters[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+
+	if req.Name != "" {
+		cluster.Name = req.Name
+	}
+	if req.Description != nil {
+		cluster.Description = *req.Description
+	}
+	if req.Labels != nil {
+		cluster.Labels = req.Labels
+	}
+	if req.Addons != nil {
+		cluster.Addons = req.Addons
+	}
+	if req.AutoUpgrade != nil {
+		cluster.AutoUpgrade = req.AutoUpgrade
+	}
+
+	s.newOperation(w, "update-sks-cluster", "/sks-cluster/"+id.String(), id, nil)
+}
+
+func (s *Server) upgradeSKSCluster(w http.ResponseWriter, r *http.Request) {
+	var req v3.UpgradeSKSClusterRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	cluster, ok := s.sksClusters[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+	if cluster.State != v3.SKSClusterStateRunning {
+		writeError(w, http.StatusConflict, "SKS cluster is not running")
+		return
+	}
+
+	cluster.State = v3.SKSClusterStateUpgrading
+	s.newOperation(w, "upgrade-sks-cluster", "/sks-cluster/"+id.String(), id, func() {
+		cluster.Version = req.Version
+		cluster.State = v3.SKSClusterStateRunning
+	})
+}
+
+func (s *Server) deleteSKSCluster(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	cluster, ok := s.sksClusters[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+	if len(cluster.Nodepools) > 0 {
+		writeError(w, http.StatusConflict, "SKS cluster has Nodepools")
+		return
+	}
+	delete(s.sksClusters, id)
+
+	s.newOperation(w, "delete-sks-cluster", "/sks-cluster/"+id.String(), id, nil)
+}
+
+func (s *Server) createSKSNodepool(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateSKSNodepoolRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.Size <= 0 {
+		writeError(w, http.StatusBadRequest, "missing name or size")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	cluster, ok := s.sksClusters[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+
+	nodepool := v3.SKSNodepool{
+		ID:                 newUUID(),
+		Name:               req.Name,
+		Description:        req.Description,
+		Addons:             req.Addons,
+		AntiAffinityGroups: req.AntiAffinityGroups,
+		DeployTarget:       req.DeployTarget,
+		DiskSize:           req.DiskSize,
+		InstancePrefix:     req.InstancePrefix,
+		InstanceType:       req.InstanceType,
+		KubeletImageGC:     req.KubeletImageGC,
+		Labels:             req.Labels,
+		PrivateNetworks:    req.PrivateNetworks,
+		SecurityGroups:     req.SecurityGroups,
+		Size:               req.Size,
+		Taints:             req.Taints,
+		Version:            cluster.Version,
+		CreatedAT:          time.Now().UTC(),
+		State:              v3.SKSNodepoolStateCreating,
+	}
+	prefix := req.InstancePrefix
+	if prefix == "" {
+		prefix = "pool"
+	}
+	pool := &v3.InstancePool{
+		ID:             newUUID(),
+		Name:           "nodepool-" + req.Name + "-" + nodepool.ID.String()[:5],
+		DiskSize:       req.DiskSize,
+		InstancePrefix: prefix,
+		InstanceType:   req.InstanceType,
+		Manager:        &v3.Manager{ID: nodepool.ID, Type: v3.ManagerTypeSKSNodepool},
+		State:          v3.InstancePoolStateRunning,
+	}
+	s.instancePools[pool.ID] = pool
+	startInstances := s.resizeInstancePool(pool, req.Size)
+	nodepool.InstancePool = &v3.InstancePool{ID: pool.ID}
+	cluster.Nodepools = append(cluster.Nodepools, nodepool)
+
+	s.newOperation(w, "create-sks-nodepool", "/sks-cluster/"+id.String()+"/nodepool/"+nodepool.ID.String(), nodepool.ID, func() {
+		startInstances()
+		if np := findNodepool(cluster, nodepool.ID); np != nil {
+			np.State = v3.SKSNodepoolStateRunning
+		}
+	})
+}
+
+func (s *Server) getSKSNodepool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cluster, ok := s.sksClusters[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+	nodepool := findNodepool(cluster, v3.UUID(r.PathValue("nodepool")))
+	if nodepool == nil {
+		writeError(w, http.StatusNotFound, "SKS Nodepool not found")
+		return
+	}
+
+	writeJSON(w, nodepool)
+}
+
+func (s *Server) updateSKSNodepool(w http.ResponseWriter, r *http.Request) {
+	nodepoolID, action := splitAction(r.PathValue("nodepool"))
+
+	var (
+		req   v3.UpdateSKSNodepoolRequest
+		scale v3.ScaleSKSNodepoolRequest
+		evict v3.EvictSKSNodepoolMembersRequest
+	)
+	switch action {
+	case "":
+		if !readJSON(w, r, &req) {
+			return
+		}
+	case "scale":
+		if !readJSON(w, r, &scale) {
+			return
+		}
+	case "evict":
+		if !readJSON(w, r, &evict) {
+			return
+		}
+	default:
+		writeError(w, http.StatusNotFound, "unsupported action: "+action)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	cluster, ok := s.sksClusters[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+	nodepool := findNodepool(cluster, nodepoolID)
+	if nodepool == nil {
+		writeError(w, http.StatusNotFound, "SKS Nodepool not found")
+		return
+	}
+
+	var done func()
+	switch action {
+	case "":
+		if req.Name != "" {
+			nodepool.Name = req.Name
+		}
+		if req.Description != "" {
+			nodepool.Description = req.Description
+		}
+		if req.DiskSize != 0 {
+			nodepool.DiskSize = req.DiskSize
+		}
+		if req.InstanceType != nil {
+			nodepool.InstanceType = req.InstanceType
+		}
+		if req.Labels != nil {
+			nodepool.Labels = req.Labels
+		}
+		if req.Taints != nil {
+			nodepool.Taints = req.Taints
+		}
+	case "scale":
+		if scale.Size <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid size")
+			return
+		}
+		nodepool.State = v3.SKSNodepoolStateScaling
+		startInstances := s.resizeInstancePool(s.instancePools[nodepool.InstancePool.ID], scale.Size)
+		done = func() {
+			startInstances()
+			if np := findNodepool(cluster, nodepoolID); np != nil {
+				np.Size = scale.Size
+				np.State = v3.SKSNodepoolStateRunning
+			}
+		}
+	case "evict":
+		pool := s.instancePools[nodepool.InstancePool.ID]
+		if err := s.evictInstancePoolMembers(pool, evict.Instances); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		nodepool.Size = pool.Size
+	}
+
+	s.newOperation(w, "update-sks-nodepool", "/sks-cluster/"+id.String()+"/nodepool/"+nodepoolID.String(), nodepoolID, done)
+}
+
+func (s *Server) deleteSKSNodepool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := v3.UUID(r.PathValue("id"))
+	cluster, ok := s.sksClusters[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SKS cluster not found")
+		return
+	}
+	nodepoolID := v3.UUID(r.PathValue("nodepool"))
+	nodepool := findNodepool(cluster, nodepoolID)
+	if nodepool == nil {
+		writeError(w, http.StatusNotFound, "SKS Nodepool not found")
+		return
+	}
+	if pool, ok := s.instancePools[nodepool.InstancePool.ID]; ok {
+		s.resizeInstancePool(pool, 0)
+		delete(s.instancePools, pool.ID)
+	}
+	cluster.Nodepools = slices.DeleteFunc(cluster.Nodepools, func(np v3.SKSNodepool) bool {
+		return np.ID == nodepoolID
+	})
+
+	s.newOperation(w, "delete-sks-nodepool", "/sks-cluster/"+id.String()+"/nodepool/"+nodepoolID.String(), nodepoolID, nil)
+}
+
+// findNodepool returns a pointer to a Nodepool of an SKS cluster, or nil if not found.
+// It must be called with the Server lock held.
+func findNodepool(cluster *v3.SKSCluster, id v3.UUID) *v3.SKSNodepool {
+	for i := range cluster.Nodepools {
+		if cluster.Nodepools[i].ID == id {
+			return &cluster.Nodepools[i]
+		}
+	}
+
+	return nil
+}
+
+// generateSKSClusterKubeconfig returns a kubeconfig with a self-signed client
+// certificate valid for the requested TTL.
+func (s *Server) generateSKSClusterKubeconfig(w http.ResponseWriter, r *http.Request) {
+	var req v3.SKSKubeconfigRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	if req.User == "" {
+		writeError(w, http.StatusBadRequest, "missing user")
+		return
+	}
+
+	s.mu.Lock()
+	cluster, ok := s.sksClusters[v3.UUID(r.PathValue("id"))]
+	var name, endpoint string
+	if ok {
+		name, endpoint = cluster.Name, cluster.Endpoint
+	}
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, "cluster not found")
+		return
+	}
+
+	ttl := time.Duration(req.Ttl) * time.Second
+	if ttl == 0 {
+		ttl = 30 * 24 * time.Hour
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	now := time.Now()
+	cert, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		Subject:      pkix.Name{CommonName: req.User, Organization: req.Groups},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(ttl),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "testserver"}}, &key.PublicKey, key)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	encode := func(typ string, der []byte) string {
+		return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}))
+	}
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: %[1]s
+  cluster:
+    server: %[2]s
+contexts:
+- name: %[3]s@%[1]s
+  context:
+    cluster: %[1]s
+    user: %[3]s
+current-context: %[3]s@%[1]s
+users:
+- name: %[3]s
+  user:
+    client-certificate-data: %[4]s
+    client-key-data: %[5]s
+`, name, endpoint, req.User, encode("CERTIFICATE", cert), encode("EC PRIVATE KEY", keyDER))
+
+	writeJSON(w, v3.GenerateSKSClusterKubeconfigResponse{
+		Kubeconfig: base64.StdEncoding.EncodeToString([]byte(kubeconfig)),
+	})
+}
@@ -0,0 +1,281 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WaitProgress describes an observation made while waiting for an async
+// operation or a resource to reach a final state.
+type WaitProgress struct {
+	// Attempt is the number of polls performed so far.
+	Attempt int
+	// Elapsed is the time elapsed since the wait started.
+	Elapsed time.Duration
+	// State is the last observed state.
+	State string
+	// Resource is the last observed resource, e.g. an *Operation.
+	Resource any
+	// Err is the transient error returned by the last poll, if any.
+	Err error
+}
+
+// WaitOpt represents a function setting a wait option.
+type WaitOpt func(*waitOptions)
+
+type waitOptions struct {
+	states          []OperationState
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+	timeout         time.Duration
+	maxErrors       int
+	progress        func(WaitProgress)
+}
+
+// WaitOptWithStates returns a WaitOpt making the wait fail if the async
+// operation final state doesn't match one of the given states.
+func WaitOptWithStates(states ...OperationState) WaitOpt {
+	return func(o *waitOptions) {
+		o.states = states
+	}
+}
+
+// WaitOptWithInterval returns a WaitOpt overriding the polling intervals:
+// the interval starts at initial and grows exponentially up to maximum.
+// Non-positive intervals keep the defaults, and maximum is raised to initial
+// if lower.
+func WaitOptWithInterval(initial, maximum time.Duration) WaitOpt {
+	return func(o *waitOptions) {
+		if initial > 0 {
+			o.initialInterval = initial
+		}
+		if maximum > 0 {
+			o.maxInterval = maximum
+		}
+	}
+}
+
+// WaitOptWithTimeout returns a WaitOpt setting the maximum duration of the wait.
+func WaitOptWithTimeout(timeout time.Duration) WaitOpt {
+	return func(o *waitOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WaitOptWithMaxErrors returns a WaitOpt overriding the number of consecutive
+// transient polling errors tolerated before the wait fails.
+func WaitOptWithMaxErrors(n int) WaitOpt {
+	return func(o *waitOptions) {
+		o.maxErrors = n
+	}
+}
+
+// WaitOptWithProgress returns a WaitOpt setting a callback invoked on each
+// poll, e.g. to report progress to the user.
+func WaitOptWithProgress(fn func(WaitProgress)) WaitOpt {
+	return func(o *waitOptions) {
+		o.progress = fn
+	}
+}
+
+func (c Client) waitOptions(opts ...WaitOpt) *waitOptions {
+	o := &waitOptions{
+		initialInterval: min(time.Second, c.pollingInterval),
+		maxInterval:     c.pollingInterval,
+		multiplier:      1.5,
+		maxErrors:       5,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	o.maxInterval = max(o.maxInterval, o.initialInterval)
+
+	return o
+}
+
+// Wait is a helper that waits for async operation to reach the final state.
+// Final states are one of: failure, success, timeout.
+// If states argument are given, returns an error if the final state not match on of those.
+func (c Client) Wait(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error) {
+	return c.WaitOperation(ctx, op, WaitOptWithStates(states...))
+}
+
+// WaitOperation waits for async operation to reach the final state like Wait,
+// with the polling behavior configured by the given options.
+func (c Client) WaitOperation(ctx context.Context, op *Operation, opts ...WaitOpt) (*Operation, error) {
+	if op == nil {
+		return nil, fmt.Errorf("operation is nil")
+	}
+
+	o := c.waitOptions(opts...)
+
+	if o.progress != nil {
+		o.progress(WaitProgress{State: string(op.State), Resource: op})
+	}
+
+	operation := op
+	if op.State == OperationStatePending {
+		err := c.poll(ctx, "WaitOperation", o, func(ctx context.Context) (string, any, bool, error) {
+			res, err := c.GetOperation(ctx, op.ID)
+			if err != nil {
+				return "", nil, false, err
+			}
+			operation = res
+
+			return string(res.State), res, res.State != OperationStatePending, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(o.states) == 0 {
+		return operation, nil
+	}
+
+	for _, st := range o.states {
+		if operation.State == st {
+			return operation, nil
+		}
+	}
+
+	var ref OperationReference
+	if operation.Reference != nil {
+		ref = *operation.Reference
+	}
+
+	return nil,
+		fmt.Errorf("operation: %q %v, state: %s, reason: %q, message: %q",
+			operation.ID,
+			ref,
+			operation.State,
+			operation.Reason,
+			operation.Message,
+		)
+}
+
+// pollFn polls a resource, returning its state, the resource itself and
+// whether the wait is over.
+type pollFn func(ctx context.Context) (state string, resource any, done bool, err error)
+
+// poll calls fn until it reports done, waiting between calls with an exponential
+// backoff. Transient errors are retried up to the maximum number of consecutive errors.
+// name is the name of the waiting method, reported to the Client Instrumentation.
+func (c Client) poll(ctx context.Context, name string, o *waitOptions, fn pollFn) error {
+	if c.instrumentation == nil {
+		_, _, err := c.pollUntilDone(ctx, o, fn)
+		return err
+	}
+
+	start := time.Now()
+	ctx, done := c.instrumentation.StartWait(ctx, WaitInfo{Name: name, Zone: c.zone()})
+
+	polls, state, err := c.pollUntilDone(ctx, o, fn)
+	done(WaitResult{Polls: polls, State: state, Duration: time.Since(start), Err: err})
+
+	return err
+}
+
+// pollUntilDone implements poll, also returning the number of polls and the last state.
+func (c Client) pollUntilDone(ctx context.Context, o *waitOptions, fn pollFn) (int, string, error) {
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	var (
+		start     = time.Now()
+		interval  = o.initialInterval
+		errCount  int
+		lastState string
+	)
+
+	for attempt := 1; ; attempt++ {
+		state, resource, done, err := fn(ctx)
+		switch {
+		case err == nil:
+			errCount = 0
+			lastState = state
+		case ctx.Err() != nil:
+			return attempt, lastState, ctx.Err()
+		case !isTransientError(err):
+			return attempt, lastState, err
+		default:
+			errCount++
+			if errCount > o.maxErrors {
+				return attempt, lastState, fmt.Errorf("too many consecutive errors: %w", err)
+			}
+		}
+
+		if o.progress != nil {
+			o.progress(WaitProgress{
+				Attempt:  attempt,
+				Elapsed:  time.Since(start),
+				State:    state,
+				Resource: resource,
+				Err:      err,
+			})
+		}
+
+		if done && err == nil {
+			return attempt, lastState, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, lastState, ctx.Err()
+		}
+
+		interval = min(time.Duration(float64(interval)*o.multiplier), o.maxInterval)
+	}
+}
+
+// isTransientError returns true if an error returned by an API call is worth retrying.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, ErrNotFound) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	// Network errors.
+	return true
+}
+
+// WaitDBAASServiceRunning waits for the DBaaS service of any type to reach the running state.
+func (c Client) WaitDBAASServiceRunning(ctx context.Context, name string, opts ...WaitOpt) (*DBAASServiceCommon, error) {
+	var service *DBAASServiceCommon
+	err := c.poll(ctx, "WaitDBAASServiceRunning", c.waitOptions(opts...), func(ctx context.Context) (string, any, bool, error) {
+		services, err := c.ListDBAASServices(ctx)
+		if err != nil {
+			return "", nil, false, err
+		}
+
+		for i, s := range services.DBAASServices {
+			if string(s.Name) == name {
+				service = &services.DBAASServices[i]
+				return string(s.State), service, s.State == EnumServiceStateRunning, nil
+			}
+		}
+
+		return "", nil, false, fmt.Errorf("%q not found in ListDBAASServicesResponse: %w", name, ErrNotFound)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WaitDBAASServiceRunning: %w", err)
+	}
+
+	return service, nil
+}
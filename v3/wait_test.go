@@ -0,0 +1,98 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientWaitOperation(t *testing.T) {
+	var polls int
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		switch polls {
+		case 1:
+			_, _ = w.Write([]byte(`{"id":"op","state":"pending"}`))
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"id":"op","state":"success"}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	var progress []WaitProgress
+	op, err := client.WaitOperation(
+		context.Background(),
+		&Operation{ID: "op", State: OperationStatePending},
+		WaitOptWithStates(OperationStateSuccess),
+		WaitOptWithInterval(time.Millisecond, 5*time.Millisecond),
+		WaitOptWithTimeout(time.Second),
+		WaitOptWithProgress(func(p WaitProgress) {
+			progress = append(progress, p)
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, OperationStateSuccess, op.State)
+	require.Equal(t, 3, polls)
+
+	require.Len(t, progress, 4)
+	require.Equal(t, string(OperationStatePending), progress[0].State)
+	require.ErrorIs(t, progress[2].Err, ErrAPIError)
+	require.Equal(t, string(OperationStateSuccess), progress[3].State)
+}
+
+func TestClientWaitOperationFailure(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"op","state":"failure","reason":"forbidden"}`))
+	}))
+	defer ts.Close()
+
+	client := newTestClient(t, ts.URL)
+
+	_, err := client.Wait(context.Background(), &Operation{ID: "op", State: OperationStatePending}, OperationStateSuccess)
+	require.ErrorContains(t, err, `state: failure, reason: "forbidden"`)
+}
+
+func TestClientWaitOperationDone(t *testing.T) {
+	// Operations already done are not polled.
+	client := newTestClient(t, "http://127.0.0.1:0")
+	ctx := context.Background()
+
+	failed := &Operation{ID: "op", State: OperationStateFailure, Reason: OperationReasonForbidden}
+
+	op, err := client.Wait(ctx, failed)
+	require.NoError(t, err)
+	require.Equal(t, failed, op)
+
+	_, err = client.Wait(ctx, failed, OperationStateSuccess)
+	require.ErrorContains(t, err, `state: failure, reason: "forbidden"`)
+
+	op, err = client.Wait(ctx, failed, OperationStateSuccess, OperationStateFailure)
+	require.NoError(t, err)
+	require.Equal(t, failed, op)
+}
+
+func TestWaitOptWithInterval(t *testing.T) {
+	client := newTestClient(t, "http://localhost", ClientOptWithPollingInterval(2*time.Second))
+
+	o := client.waitOptions(WaitOptWithInterval(10*time.Millisecond, time.Second))
+	require.Equal(t, 10*time.Millisecond, o.initialInterval)
+	require.Equal(t, time.Second, o.maxInterval)
+
+	// Non-positive intervals would make the wait busy-poll the API.
+	o = client.waitOptions(WaitOptWithInterval(0, -time.Second))
+	require.Equal(t, time.Second, o.initialInterval)
+	require.Equal(t, 2*time.Second, o.maxInterval)
+
+	o = client.waitOptions(WaitOptWithInterval(5*time.Second, time.Second))
+	require.Equal(t, 5*time.Second, o.initialInterval)
+	require.Equal(t, 5*time.Second, o.maxInterval)
+}
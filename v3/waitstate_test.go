@@ -0,0 +1,136 @@
+package v3_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/sauterp/egoscale/v3"
+	"github.com/sauterp/egoscale/v3/testserver"
+)
+
+// fastPolling makes the waits poll every millisecond, and fail after a second.
+var fastPolling = []v3.WaitOpt{
+	v3.WaitOptWithInterval(time.Millisecond, time.Millisecond),
+	v3.WaitOptWithTimeout(time.Second),
+}
+
+// onState returns a WaitOpt calling fn once, when the polled resource is in a state.
+func onState(state string, fn func()) v3.WaitOpt {
+	called := false
+	return v3.WaitOptWithProgress(func(p v3.WaitProgress) {
+		if p.State == state && !called {
+			called = true
+			fn()
+		}
+	})
+}
+
+func TestWaitInstanceState(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	// The instance stays starting until the create operation is polled.
+	op, err := client.CreateInstance(ctx, v3.CreateInstanceRequest{
+		Name:         "test",
+		InstanceType: &v3.InstanceType{ID: "5c3d8a58-7b64-4e4e-9a3e-4c4d2b6f1e2a"},
+		Template:     &v3.Template{ID: "5c3d8a58-7b64-4e4e-9a3e-4c4d2b6f1e2a"},
+		DiskSize:     10,
+	})
+	require.NoError(t, err)
+	id := op.Reference.ID
+
+	t.Run("Reached", func(t *testing.T) {
+		instance, err := client.WaitInstanceState(ctx, id, v3.InstanceStateRunning, append(fastPolling,
+			onState(string(v3.InstanceStateStarting), func() { s.SetInstanceState(id, v3.InstanceStateRunning) }),
+		)...)
+		require.NoError(t, err)
+		require.Equal(t, v3.InstanceStateRunning, instance.State)
+	})
+
+	t.Run("ErrorState", func(t *testing.T) {
+		s.SetInstanceState(id, v3.InstanceStateStarting)
+
+		start := time.Now()
+		instance, err := client.WaitInstanceState(ctx, id, v3.InstanceStateRunning, append(fastPolling,
+			onState(string(v3.InstanceStateStarting), func() { s.SetInstanceState(id, v3.InstanceStateError) }),
+		)...)
+		require.ErrorContains(t, err, `WaitInstanceState: unexpected state "error"`)
+		require.NotErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, v3.InstanceStateError, instance.State)
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		s.SetInstanceState(id, v3.InstanceStateStarting)
+
+		_, err := client.WaitInstanceState(ctx, id, v3.InstanceStateRunning,
+			v3.WaitOptWithInterval(time.Millisecond, time.Millisecond),
+			v3.WaitOptWithTimeout(50*time.Millisecond),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := client.WaitInstanceState(ctx, "5c3d8a58-7b64-4e4e-9a3e-4c4d2b6f1e2a", v3.InstanceStateRunning, fastPolling...)
+		require.ErrorIs(t, err, v3.ErrNotFound)
+	})
+}
+
+func TestWaitDBAASServiceState(t *testing.T) {
+	ctx := context.Background()
+
+	s := testserver.New()
+	defer s.Close()
+
+	s.AddDBAASService(&v3.DBAASServicePG{Name: "pg", Plan: "hobbyist-2", State: v3.EnumServiceStateRebuilding})
+
+	client, err := s.Client()
+	require.NoError(t, err)
+
+	t.Run("Generated", func(t *testing.T) {
+		service, err := client.WaitDBAASServicePGState(ctx, "pg", v3.EnumServiceStateRunning, append(fastPolling,
+			onState(string(v3.EnumServiceStateRebuilding), func() { s.SetDBAASServiceState("pg", v3.EnumServiceStateRunning) }),
+		)...)
+		require.NoError(t, err)
+		require.Equal(t, v3.EnumServiceStateRunning, service.State)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		s.SetDBAASServiceState("pg", v3.EnumServiceStateRebuilding)
+
+		service, err := client.WaitDBAASServiceRunning(ctx, "pg", append(fastPolling,
+			onState(string(v3.EnumServiceStateRebuilding), func() { s.SetDBAASServiceState("pg", v3.EnumServiceStateRunning) }),
+		)...)
+		require.NoError(t, err)
+		require.Equal(t, v3.EnumServiceStateRunning, service.State)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		s.SetDBAASServiceState("pg", v3.EnumServiceStatePoweroff)
+
+		_, err := client.WaitDBAASServicePGState(ctx, "pg", v3.EnumServiceStateRunning,
+			v3.WaitOptWithInterval(time.Millisecond, time.Millisecond),
+			v3.WaitOptWithTimeout(50*time.Millisecond),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, err = client.WaitDBAASServiceRunning(ctx, "pg",
+			v3.WaitOptWithInterval(time.Millisecond, time.Millisecond),
+			v3.WaitOptWithTimeout(50*time.Millisecond),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := client.WaitDBAASServiceRunning(ctx, "missing", fastPolling...)
+		require.ErrorIs(t, err, v3.ErrNotFound)
+	})
+}